@@ -131,6 +131,7 @@ func parseLivepeerConfig() starter.LivepeerConfig {
 	cfg.SelectRandWeight = flag.Float64("selectRandFreq", *cfg.SelectRandWeight, "Weight of the random factor in the orchestrator selection algorithm")
 	cfg.SelectStakeWeight = flag.Float64("selectStakeWeight", *cfg.SelectStakeWeight, "Weight of the stake factor in the orchestrator selection algorithm")
 	cfg.SelectPriceWeight = flag.Float64("selectPriceWeight", *cfg.SelectPriceWeight, "Weight of the price factor in the orchestrator selection algorithm")
+	cfg.SelectDiscoveryLatencyWeight = flag.Float64("selectDiscoveryLatencyWeight", *cfg.SelectDiscoveryLatencyWeight, "Weight of the orchestrator's discovery round trip time in the orchestrator selection algorithm. The round trip time is measured once when the orchestrator is discovered and is not refreshed afterwards")
 	cfg.SelectPriceExpFactor = flag.Float64("selectPriceExpFactor", *cfg.SelectPriceExpFactor, "Expresses how significant a small change of price is for the selection algorithm; default 100")
 	cfg.OrchPerfStatsURL = flag.String("orchPerfStatsUrl", *cfg.OrchPerfStatsURL, "URL of Orchestrator Performance Stream Tester")
 	cfg.Region = flag.String("region", *cfg.Region, "Region in which a broadcaster is deployed; used to select the region while using the orchestrator's performance stats")
@@ -146,6 +147,7 @@ func parseLivepeerConfig() starter.LivepeerConfig {
 	cfg.TranscodingOptions = flag.String("transcodingOptions", *cfg.TranscodingOptions, "Transcoding options for broadcast job, or path to json config")
 	cfg.MaxAttempts = flag.Int("maxAttempts", *cfg.MaxAttempts, "Maximum transcode attempts")
 	cfg.MaxSessions = flag.String("maxSessions", *cfg.MaxSessions, "Maximum number of concurrent transcoding sessions for Orchestrator or 'auto' for dynamic limit, maximum number of RTMP streams for Broadcaster, or maximum capacity for transcoder.")
+	cfg.StreamControlToken = flag.String("streamControlToken", *cfg.StreamControlToken, "Bearer token required by the /localStreams, /stopStream and /swapOrchestrator CLI endpoints. If empty, the endpoints are available to anyone that can reach -cliAddr")
 	cfg.CurrentManifest = flag.Bool("currentManifest", *cfg.CurrentManifest, "Expose the currently active ManifestID as \"/stream/current.m3u8\"")
 	cfg.Nvidia = flag.String("nvidia", *cfg.Nvidia, "Comma-separated list of Nvidia GPU device IDs (or \"all\" for all available devices)")
 	cfg.Netint = flag.String("netint", *cfg.Netint, "Comma-separated list of NetInt device GUIDs (or \"all\" for all available devices)")
@@ -186,6 +188,9 @@ func parseLivepeerConfig() starter.LivepeerConfig {
 	// Redemption service
 	cfg.Redeemer = flag.Bool("redeemer", *cfg.Redeemer, "Set to true to run a ticket redemption service")
 	cfg.RedeemerAddr = flag.String("redeemerAddr", *cfg.RedeemerAddr, "URL of the ticket redemption service to use")
+	cfg.TicketRedeemBatchSize = flag.Int("ticketRedeemBatchSize", *cfg.TicketRedeemBatchSize, "Maximum number of winning tickets from a sender to redeem in a single transaction")
+	cfg.TicketRedeemMaxGasPrice = flag.Int("ticketRedeemMaxGasPrice", *cfg.TicketRedeemMaxGasPrice, "Gas price in wei above which ticket redemptions are deferred. Set to 0 to disable")
+	cfg.TicketRedeemMaxDelay = flag.Duration("ticketRedeemMaxDelay", *cfg.TicketRedeemMaxDelay, "Maximum time to defer a ticket redemption while the gas price is above -ticketRedeemMaxGasPrice")
 	// Reward service
 	cfg.Reward = flag.Bool("reward", false, "Set to true to run a reward service")
 	// Metrics & logging:
@@ -102,6 +102,7 @@ type LivepeerConfig struct {
 	MinPerfScore            *float64
 	MaxSessions             *string
 	CurrentManifest         *bool
+	StreamControlToken      *string
 	Nvidia                  *string
 	Netint                  *string
 	TestTranscoder          *bool
@@ -131,6 +132,9 @@ type LivepeerConfig struct {
 	BlockPollingInterval    *int
 	Redeemer                *bool
 	RedeemerAddr            *string
+	TicketRedeemBatchSize   *int
+	TicketRedeemMaxGasPrice *int
+	TicketRedeemMaxDelay    *time.Duration
 	Reward                  *bool
 	Monitor                 *bool
 	MetricsPerStream        *bool
@@ -148,6 +152,8 @@ type LivepeerConfig struct {
 	OrchBlacklist           *string
 	OrchMinLivepeerVersion  *string
 	TestOrchAvail           *bool
+
+	SelectDiscoveryLatencyWeight *float64
 }
 
 // DefaultLivepeerConfig creates LivepeerConfig exactly the same as when no flags are passed to the livepeer process.
@@ -173,12 +179,14 @@ func DefaultLivepeerConfig() LivepeerConfig {
 	defaultSelectRandWeight := 0.3
 	defaultSelectStakeWeight := 0.7
 	defaultSelectPriceWeight := 0.0
+	defaultSelectDiscoveryLatencyWeight := 0.0
 	defaultSelectPriceExpFactor := 100.0
 	defaultMaxSessions := strconv.Itoa(10)
 	defaultOrchPerfStatsURL := ""
 	defaultRegion := ""
 	defaultMinPerfScore := 0.0
 	defaultCurrentManifest := false
+	defaultStreamControlToken := ""
 	defaultNvidia := ""
 	defaultNetint := ""
 	defaultTestTranscoder := true
@@ -210,6 +218,9 @@ func DefaultLivepeerConfig() LivepeerConfig {
 	defaultBlockPollingInterval := 5
 	defaultRedeemer := false
 	defaultRedeemerAddr := ""
+	defaultTicketRedeemBatchSize := 1
+	defaultTicketRedeemMaxGasPrice := 0
+	defaultTicketRedeemMaxDelay := 1 * time.Hour
 	defaultMonitor := false
 	defaultMetricsPerStream := false
 	defaultMetricsExposeClientIP := false
@@ -268,6 +279,7 @@ func DefaultLivepeerConfig() LivepeerConfig {
 		Region:               &defaultRegion,
 		MinPerfScore:         &defaultMinPerfScore,
 		CurrentManifest:      &defaultCurrentManifest,
+		StreamControlToken:   &defaultStreamControlToken,
 		Nvidia:               &defaultNvidia,
 		Netint:               &defaultNetint,
 		TestTranscoder:       &defaultTestTranscoder,
@@ -299,6 +311,9 @@ func DefaultLivepeerConfig() LivepeerConfig {
 		BlockPollingInterval:    &defaultBlockPollingInterval,
 		Redeemer:                &defaultRedeemer,
 		RedeemerAddr:            &defaultRedeemerAddr,
+		TicketRedeemBatchSize:   &defaultTicketRedeemBatchSize,
+		TicketRedeemMaxGasPrice: &defaultTicketRedeemMaxGasPrice,
+		TicketRedeemMaxDelay:    &defaultTicketRedeemMaxDelay,
 		Monitor:                 &defaultMonitor,
 		MetricsPerStream:        &defaultMetricsPerStream,
 		MetricsExposeClientIP:   &defaultMetricsExposeClientIP,
@@ -328,6 +343,9 @@ func DefaultLivepeerConfig() LivepeerConfig {
 
 		// Flags
 		TestOrchAvail: &defaultTestOrchAvail,
+
+		// Selection:
+		SelectDiscoveryLatencyWeight: &defaultSelectDiscoveryLatencyWeight,
 	}
 }
 
@@ -749,13 +767,25 @@ func StartLivepeer(ctx context.Context, cfg LivepeerConfig) {
 			recipientAddr = ethcommon.HexToAddress(*cfg.EthOrchAddr)
 		}
 
+		if *cfg.TicketRedeemBatchSize < 1 {
+			glog.Errorf("-ticketRedeemBatchSize must be at least 1, provided %v", *cfg.TicketRedeemBatchSize)
+			return
+		}
+		var redeemMaxGasPrice *big.Int
+		if *cfg.TicketRedeemMaxGasPrice > 0 {
+			redeemMaxGasPrice = big.NewInt(int64(*cfg.TicketRedeemMaxGasPrice))
+		}
+
 		smCfg := &pm.LocalSenderMonitorConfig{
-			Claimant:        recipientAddr,
-			CleanupInterval: cleanupInterval,
-			TTL:             smTTL,
-			RedeemGas:       redeemGas,
-			SuggestGasPrice: client.Backend().SuggestGasPrice,
-			RPCTimeout:      ethRPCTimeout,
+			Claimant:          recipientAddr,
+			CleanupInterval:   cleanupInterval,
+			TTL:               smTTL,
+			RedeemGas:         redeemGas,
+			SuggestGasPrice:   client.Backend().SuggestGasPrice,
+			RPCTimeout:        ethRPCTimeout,
+			RedeemBatchSize:   *cfg.TicketRedeemBatchSize,
+			RedeemMaxGasPrice: redeemMaxGasPrice,
+			RedeemMaxDelay:    *cfg.TicketRedeemMaxDelay,
 		}
 
 		if *cfg.Orchestrator {
@@ -1232,6 +1262,10 @@ func StartLivepeer(ctx context.Context, cfg LivepeerConfig) {
 		glog.Info("Current ManifestID will be available over ", *cfg.HttpAddr)
 		s.ExposeCurrentManifest = *cfg.CurrentManifest
 	}
+	s.StreamControlToken = *cfg.StreamControlToken
+	if s.StreamControlToken == "" && n.NodeType == core.BroadcasterNode {
+		glog.Warningf("No -streamControlToken set, the /localStreams, /stopStream and /swapOrchestrator endpoints are available to anyone that can reach -cliAddr=%v", *cfg.CliAddr)
+	}
 	srv := &http.Server{Addr: *cfg.CliAddr}
 	go func() {
 		s.StartCliWebserver(srv)
@@ -1558,11 +1592,11 @@ func getGatewayPrices(gatewayPrices string) []GatewayPrice {
 }
 
 func createSelectionAlgorithm(cfg LivepeerConfig) (common.SelectionAlgorithm, error) {
-	sumWeight := *cfg.SelectStakeWeight + *cfg.SelectPriceWeight + *cfg.SelectRandWeight
+	sumWeight := *cfg.SelectStakeWeight + *cfg.SelectPriceWeight + *cfg.SelectRandWeight + *cfg.SelectDiscoveryLatencyWeight
 	if math.Abs(sumWeight-1.0) > 0.0001 {
 		return nil, fmt.Errorf(
-			"sum of selection algorithm weights must be 1.0, stakeWeight=%v, priceWeight=%v, randWeight=%v",
-			*cfg.SelectStakeWeight, *cfg.SelectPriceWeight, *cfg.SelectRandWeight)
+			"sum of selection algorithm weights must be 1.0, stakeWeight=%v, priceWeight=%v, randWeight=%v, discoveryLatencyWeight=%v",
+			*cfg.SelectStakeWeight, *cfg.SelectPriceWeight, *cfg.SelectRandWeight, *cfg.SelectDiscoveryLatencyWeight)
 	}
 	return server.ProbabilitySelectionAlgorithm{
 		MinPerfScore:   *cfg.MinPerfScore,
@@ -1570,6 +1604,8 @@ func createSelectionAlgorithm(cfg LivepeerConfig) (common.SelectionAlgorithm, er
 		PriceWeight:    *cfg.SelectPriceWeight,
 		RandWeight:     *cfg.SelectRandWeight,
 		PriceExpFactor: *cfg.SelectPriceExpFactor,
+
+		DiscoveryLatencyWeight: *cfg.SelectDiscoveryLatencyWeight,
 	}, nil
 }
 
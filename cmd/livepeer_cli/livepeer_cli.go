@@ -29,6 +29,10 @@ func main() {
 			Usage: "host for the Livepeer node",
 			Value: "localhost",
 		},
+		cli.StringFlag{
+			Name:  "streamControlToken",
+			Usage: "bearer token for the stream control endpoints of the Livepeer node",
+		},
 		cli.IntFlag{
 			Name:  "loglevel",
 			Value: 4,
@@ -50,6 +54,8 @@ func main() {
 			httpPort: c.String("http"),
 			host:     c.String("host"),
 			in:       bufio.NewReader(os.Stdin),
+
+			streamControlToken: c.String("streamControlToken"),
 		}
 		w.orchestrator = w.isOrchestrator()
 		w.redeemer = w.isRedeemer()
@@ -72,6 +78,9 @@ type wizard struct {
 	testnet      bool
 	offchain     bool
 	in           *bufio.Reader // Wrapper around stdin to allow reading user input
+
+	// Bearer token for the stream control endpoints
+	streamControlToken string
 }
 
 type wizardOpt struct {
@@ -102,6 +111,9 @@ func (w *wizard) initializeOptions() []wizardOpt {
 		{desc: "Invoke \"cancel unlock of broadcasting funds\"", invoke: w.cancelUnlock, notOrchestrator: true},
 		{desc: "Invoke \"withdraw broadcasting funds\"", invoke: w.withdraw, notOrchestrator: true},
 		{desc: "Set broadcast config", invoke: w.setBroadcastConfig, notOrchestrator: true},
+		{desc: "List active streams", invoke: w.listStreams, notOrchestrator: true},
+		{desc: "Stop an active stream", invoke: w.stopStream, notOrchestrator: true},
+		{desc: "Swap orchestrator for an active stream", invoke: w.swapOrchestrator, notOrchestrator: true},
 		{desc: "Set maximum Ethereum gas price", invoke: w.setMaxGasPrice},
 		{desc: "Set minimum Ethereum gas price", invoke: w.setMinGasPrice},
 		{desc: "Get test LPT", invoke: w.requestTokens, testnet: true},
@@ -282,6 +282,32 @@ func httpGet(url string) string {
 
 }
 
+func httpGetWithHeaders(url string, headers map[string]string) (string, bool) {
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		log.Error("Error creating HTTP GET", "url", url, "err", err)
+		return "", false
+	}
+
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		log.Error("Error sending HTTP GET", "url", url, "err", err)
+		return "", false
+	}
+
+	defer resp.Body.Close()
+	result, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", false
+	}
+
+	return string(result), resp.StatusCode >= 200 && resp.StatusCode < 300
+}
+
 func httpPostWithParams(url string, val url.Values) (string, bool) {
 	return httpPostWithParamsHeaders(url, val, map[string]string{})
 }
@@ -1,13 +1,20 @@
 package main
 
 import (
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"net/url"
+	"os"
 	"os/exec"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/golang/glog"
+	lcommon "github.com/livepeer/go-livepeer/common"
+	"github.com/olekukonko/tablewriter"
 )
 
 func (w *wizard) stream() {
@@ -61,3 +68,99 @@ func (w *wizard) stream() {
 
 	return
 }
+
+func (w *wizard) activeStreams() ([]lcommon.ActiveStream, error) {
+	resp, ok := httpGetWithHeaders(fmt.Sprintf("http://%v:%v/localStreams", w.host, w.httpPort), w.streamControlHeaders())
+	if !ok {
+		return nil, fmt.Errorf("%v", strings.TrimSpace(resp))
+	}
+	var streams []lcommon.ActiveStream
+	if resp == "" {
+		return streams, nil
+	}
+	if err := json.Unmarshal([]byte(resp), &streams); err != nil {
+		return nil, err
+	}
+	return streams, nil
+}
+
+func (w *wizard) listStreams() {
+	streams, err := w.activeStreams()
+	if err != nil {
+		glog.Errorf("Error getting active streams: %v", err)
+		return
+	}
+	if len(streams) == 0 {
+		fmt.Println("No active streams")
+		return
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"ManifestID", "External ManifestID", "Profiles", "Orchestrators", "Source Bytes", "Transcoded Bytes", "Last Used"})
+	for _, s := range streams {
+		table.Append([]string{
+			s.ManifestID,
+			s.ExternalManifestID,
+			strings.Join(s.Profiles, ", "),
+			strings.Join(s.Orchestrators, ", "),
+			strconv.FormatUint(s.SourceBytes, 10),
+			strconv.FormatUint(s.TranscodedBytes, 10),
+			s.LastUsed.Format(time.RFC3339),
+		})
+	}
+	table.SetAlignment(tablewriter.ALIGN_LEFT)
+	table.SetCenterSeparator("*")
+	table.SetColumnSeparator("|")
+	table.Render()
+}
+
+func (w *wizard) streamControlHeaders() map[string]string {
+	headers := map[string]string{}
+	if w.streamControlToken != "" {
+		headers["Authorization"] = "Bearer " + w.streamControlToken
+	}
+	return headers
+}
+
+func (w *wizard) readManifestID() string {
+	fmt.Println("Enter ManifestID:")
+	return w.readStringAndValidate(func(in string) (string, error) {
+		if in == "" {
+			return "", fmt.Errorf("ManifestID must not be empty")
+		}
+		return in, nil
+	})
+}
+
+func (w *wizard) stopStream() {
+	data := url.Values{
+		"manifestID": {w.readManifestID()},
+	}
+	result, ok := httpPostWithParamsHeaders(fmt.Sprintf("http://%v:%v/stopStream", w.host, w.httpPort), data, w.streamControlHeaders())
+	if ok {
+		fmt.Print(result)
+		return
+	}
+	fmt.Printf("Error stopping stream: %v", result)
+}
+
+func (w *wizard) swapOrchestrator() {
+	data := url.Values{
+		"manifestID": {w.readManifestID()},
+	}
+	result, ok := httpPostWithParamsHeaders(fmt.Sprintf("http://%v:%v/swapOrchestrator", w.host, w.httpPort), data, w.streamControlHeaders())
+	if !ok {
+		fmt.Printf("Error swapping orchestrator: %v", result)
+		return
+	}
+	var orchs []string
+	if err := json.Unmarshal([]byte(result), &orchs); err != nil {
+		glog.Errorf("Error parsing swapped orchestrators: %v", err)
+		return
+	}
+	if len(orchs) == 0 {
+		fmt.Println("Stream is not using any orchestrators yet")
+		return
+	}
+	fmt.Printf("Swapped out orchestrators: %v\n", strings.Join(orchs, ", "))
+}
@@ -33,7 +33,7 @@ type DB struct {
 	unbondingLocks                   *sql.Stmt
 	withdrawableUnbondingLocks       *sql.Stmt
 	insertWinningTicket              *sql.Stmt
-	selectEarliestWinningTicket      *sql.Stmt
+	selectEarliestWinningTickets     *sql.Stmt
 	winningTicketCount               *sql.Stmt
 	markWinningTicketRedeemed        *sql.Stmt
 	removeWinningTicket              *sql.Stmt
@@ -289,14 +289,14 @@ func InitDB(dbPath string) (*DB, error) {
 	}
 	d.insertWinningTicket = stmt
 
-	// Select earliest ticket
-	stmt, err = db.Prepare("SELECT sender, recipient, faceValue, winProb, senderNonce, recipientRand, recipientRandHash, sig, creationRound, creationRoundBlockHash, paramsExpirationBlock FROM ticketQueue WHERE sender=? AND creationRound >= ? AND redeemedAt IS NULL AND txHash IS NULL ORDER BY createdAt ASC LIMIT 1")
+	// Select earliest tickets
+	stmt, err = db.Prepare("SELECT sender, recipient, faceValue, winProb, senderNonce, recipientRand, recipientRandHash, sig, creationRound, creationRoundBlockHash, paramsExpirationBlock FROM ticketQueue WHERE sender=? AND creationRound >= ? AND redeemedAt IS NULL AND txHash IS NULL ORDER BY createdAt ASC LIMIT ? OFFSET ?")
 	if err != nil {
-		glog.Error("Unable to prepare selectEarliestWinningTicket ", err)
+		glog.Error("Unable to prepare selectEarliestWinningTickets ", err)
 		d.Close()
 		return nil, err
 	}
-	d.selectEarliestWinningTicket = stmt
+	d.selectEarliestWinningTickets = stmt
 
 	stmt, err = db.Prepare("SELECT count(sig) FROM ticketQueue WHERE sender=? AND creationRound >= ? AND redeemedAt IS NULL AND txHash IS NULL")
 	if err != nil {
@@ -393,8 +393,8 @@ func (db *DB) Close() {
 	if db.insertWinningTicket != nil {
 		db.insertWinningTicket.Close()
 	}
-	if db.selectEarliestWinningTicket != nil {
-		db.selectEarliestWinningTicket.Close()
+	if db.selectEarliestWinningTickets != nil {
+		db.selectEarliestWinningTickets.Close()
 	}
 	if db.winningTicketCount != nil {
 		db.winningTicketCount.Close()
@@ -751,8 +751,41 @@ func (db *DB) RemoveWinningTicket(ticket *pm.SignedTicket) error {
 
 // SelectEarliestWinningTicket selects the earliest stored winning ticket for a 'sender' that is not expired and not yet redeemed
 func (db *DB) SelectEarliestWinningTicket(sender ethcommon.Address, minCreationRound int64) (*pm.SignedTicket, error) {
+	tickets, err := db.SelectEarliestWinningTickets(sender, minCreationRound, 1, 0)
+	if err != nil {
+		return nil, err
+	}
+	// If there is no result return no error, just nil value
+	if len(tickets) == 0 {
+		return nil, nil
+	}
+	return tickets[0], nil
+}
+
+// SelectEarliestWinningTickets selects up to 'limit' of the earliest stored winning tickets for a 'sender' that are not expired and not yet redeemed,
+// skipping the first 'offset' of them
+func (db *DB) SelectEarliestWinningTickets(sender ethcommon.Address, minCreationRound int64, limit, offset int) ([]*pm.SignedTicket, error) {
+	rows, err := db.selectEarliestWinningTickets.Query(sender.Hex(), minCreationRound, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve earliest tickets err=%q", err)
+	}
+	defer rows.Close()
+
+	var tickets []*pm.SignedTicket
+	for rows.Next() {
+		ticket, err := scanWinningTicket(rows, sender)
+		if err != nil {
+			return nil, fmt.Errorf("could not retrieve earliest tickets err=%q", err)
+		}
+		tickets = append(tickets, ticket)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("could not retrieve earliest tickets err=%q", err)
+	}
+	return tickets, nil
+}
 
-	row := db.selectEarliestWinningTicket.QueryRow(sender.Hex(), minCreationRound)
+func scanWinningTicket(rows *sql.Rows, sender ethcommon.Address) (*pm.SignedTicket, error) {
 	var (
 		senderString           string
 		recipient              string
@@ -766,12 +799,8 @@ func (db *DB) SelectEarliestWinningTicket(sender ethcommon.Address, minCreationR
 		creationRoundBlockHash string
 		paramsExpirationBlock  int64
 	)
-	if err := row.Scan(&senderString, &recipient, &faceValue, &winProb, &senderNonce, &recipientRand, &recipientRandHash, &sig, &creationRound, &creationRoundBlockHash, &paramsExpirationBlock); err != nil {
-		if err.Error() != "sql: no rows in result set" {
-			return nil, fmt.Errorf("could not retrieve earliest ticket err=%q", err)
-		}
-		// If there is no result return no error, just nil value
-		return nil, nil
+	if err := rows.Scan(&senderString, &recipient, &faceValue, &winProb, &senderNonce, &recipientRand, &recipientRandHash, &sig, &creationRound, &creationRoundBlockHash, &paramsExpirationBlock); err != nil {
+		return nil, err
 	}
 
 	return &pm.SignedTicket{
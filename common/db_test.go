@@ -1074,6 +1074,66 @@ func TestSelectEarliestWinningTicket(t *testing.T) {
 
 }
 
+func TestSelectEarliestWinningTickets(t *testing.T) {
+	assert := assert.New(t)
+	dbh, dbraw, err := TempDB(t)
+	defer dbh.Close()
+	defer dbraw.Close()
+	require := require.New(t)
+	require.Nil(err)
+
+	sender := ethcommon.HexToAddress("charizard")
+
+	// no tickets found
+	tickets, err := dbh.SelectEarliestWinningTickets(sender, 0, 10, 0)
+	assert.Nil(err)
+	assert.Empty(tickets)
+
+	var signedTickets []*pm.SignedTicket
+	for i := 0; i < 3; i++ {
+		_, ticket, _, _ := defaultWinningTicket(t)
+		ticket.Sender = sender
+		signedTicket := &pm.SignedTicket{
+			Ticket:        ticket,
+			Sig:           pm.RandBytes(32),
+			RecipientRand: new(big.Int).SetBytes(pm.RandBytes(32)),
+		}
+		err = dbh.StoreWinningTicket(signedTicket)
+		require.Nil(err)
+		signedTickets = append(signedTickets, signedTicket)
+	}
+	defaultCreationRound := signedTickets[0].CreationRound
+
+	tickets, err = dbh.SelectEarliestWinningTickets(sender, defaultCreationRound, 10, 0)
+	assert.Nil(err)
+	assert.ElementsMatch(signedTickets, tickets)
+
+	// Test limit
+	tickets, err = dbh.SelectEarliestWinningTickets(sender, defaultCreationRound, 2, 0)
+	assert.Nil(err)
+	assert.Len(tickets, 2)
+
+	// Test offset
+	tickets, err = dbh.SelectEarliestWinningTickets(sender, defaultCreationRound, 10, 2)
+	assert.Nil(err)
+	assert.Len(tickets, 1)
+	tickets, err = dbh.SelectEarliestWinningTickets(sender, defaultCreationRound, 10, 3)
+	assert.Nil(err)
+	assert.Empty(tickets)
+
+	// Test excluding expired tickets
+	tickets, err = dbh.SelectEarliestWinningTickets(sender, defaultCreationRound+100, 10, 0)
+	assert.Nil(err)
+	assert.Empty(tickets)
+
+	// Test excluding submitted tickets
+	err = dbh.MarkWinningTicketRedeemed(signedTickets[0], pm.RandHash())
+	require.Nil(err)
+	tickets, err = dbh.SelectEarliestWinningTickets(sender, defaultCreationRound, 10, 0)
+	assert.Nil(err)
+	assert.ElementsMatch(signedTickets[1:], tickets)
+}
+
 func TestMarkWinningTicketRedeemed_GivenNilTicket_ReturnsError(t *testing.T) {
 	dbh, dbraw, err := TempDB(t)
 	defer dbh.Close()
@@ -6,6 +6,7 @@ import (
 	"math/big"
 	"net/url"
 	"sync"
+	"time"
 
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/livepeer/go-livepeer/net"
@@ -22,6 +23,19 @@ type StreamInfo struct {
 	TranscodedBytes uint64
 }
 
+// ActiveStream describes a stream that is currently being transcoded by a gateway
+type ActiveStream struct {
+	ManifestID string
+	// External manifest ID provided in the HTTP push URL, if it differs from ManifestID
+	ExternalManifestID string
+	Profiles           []string
+	// Service URIs of the orchestrators that the last segment was sent to
+	Orchestrators   []string
+	SourceBytes     uint64
+	TranscodedBytes uint64
+	LastUsed        time.Time
+}
+
 type NodeStatus struct {
 	Manifests map[string]*m3u8.MasterPlaylist
 	// maps external manifest (provided in HTTP push URL to the internal one
@@ -65,6 +79,9 @@ type OrchestratorLocalInfo struct {
 type OrchestratorDescriptor struct {
 	LocalInfo  *OrchestratorLocalInfo
 	RemoteInfo *net.OrchestratorInfo
+	// round trip time of the request that fetched RemoteInfo, zero if unknown. It is measured
+	// once during discovery and not refreshed while the descriptor is in use
+	Latency time.Duration
 }
 
 type OrchestratorDescriptors []OrchestratorDescriptor
@@ -80,7 +97,7 @@ func (ds OrchestratorDescriptors) GetRemoteInfos() []*net.OrchestratorInfo {
 func FromRemoteInfos(infos []*net.OrchestratorInfo) OrchestratorDescriptors {
 	var ods OrchestratorDescriptors
 	for _, oi := range infos {
-		ods = append(ods, OrchestratorDescriptor{nil, oi})
+		ods = append(ods, OrchestratorDescriptor{RemoteInfo: oi})
 	}
 	return ods
 }
@@ -105,7 +122,7 @@ type OrchestratorPool interface {
 }
 
 type SelectionAlgorithm interface {
-	Select(ctx context.Context, addrs []ethcommon.Address, stakes map[ethcommon.Address]int64, maxPrice *big.Rat, prices map[ethcommon.Address]*big.Rat, perfScores map[ethcommon.Address]float64) ethcommon.Address
+	Select(ctx context.Context, addrs []ethcommon.Address, stakes map[ethcommon.Address]int64, maxPrice *big.Rat, prices map[ethcommon.Address]*big.Rat, perfScores map[ethcommon.Address]float64, latencies map[ethcommon.Address]time.Duration) ethcommon.Address
 }
 
 type PerfScore struct {
@@ -106,9 +106,11 @@ func (o *orchestratorPool) GetOrchestrators(ctx context.Context, numOrchestrator
 		return caps.CompatibleWith(info.Capabilities)
 	}
 	getOrchInfo := func(ctx context.Context, od common.OrchestratorDescriptor, infoCh chan common.OrchestratorDescriptor, errCh chan error) {
+		start := time.Now()
 		info, err := serverGetOrchInfo(ctx, o.bcast, od.LocalInfo.URL)
 		if err == nil && !isBlacklisted(info) && isCompatible(info) {
 			od.RemoteInfo = info
+			od.Latency = time.Since(start)
 			infoCh <- od
 			return
 		}
@@ -132,7 +134,7 @@ func (o *orchestratorPool) GetOrchestrators(ctx context.Context, numOrchestrator
 
 	// Shuffle and create O descriptor
 	for _, i := range rand.Perm(numAvailableOrchs) {
-		go getOrchInfo(ctx, common.OrchestratorDescriptor{linfos[i], nil}, odCh, errCh)
+		go getOrchInfo(ctx, common.OrchestratorDescriptor{LocalInfo: linfos[i]}, odCh, errCh)
 	}
 
 	// try to wait for orchestrators until at least 1 is found (with the exponential backoff timout)
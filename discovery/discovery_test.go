@@ -128,6 +128,22 @@ func TestDeadLock_NewOrchestratorPoolWithPred(t *testing.T) {
 	assert.Equal("transcoderfromtestserver", infos[0].RemoteInfo.Transcoder)
 }
 
+func TestGetOrchestrators_RecordsLatency(t *testing.T) {
+	oldOrchInfo := serverGetOrchInfo
+	defer func() { serverGetOrchInfo = oldOrchInfo }()
+	serverGetOrchInfo = func(ctx context.Context, bcast common.Broadcaster, orchestratorServer *url.URL) (*net.OrchestratorInfo, error) {
+		time.Sleep(50 * time.Millisecond)
+		return &net.OrchestratorInfo{Transcoder: "transcoderfromtestserver"}, nil
+	}
+
+	assert := assert.New(t)
+	pool := NewOrchestratorPool(nil, stringsToURIs([]string{"https://127.0.0.1:8936"}), common.Score_Trusted, []string{})
+	infos, err := pool.GetOrchestrators(context.TODO(), 1, newStubSuspender(), newStubCapabilities(), common.ScoreAtLeast(0))
+	assert.Nil(err)
+	assert.Len(infos, 1)
+	assert.GreaterOrEqual(infos[0].Latency, 50*time.Millisecond)
+}
+
 func TestPoolSize(t *testing.T) {
 	addresses := stringsToURIs([]string{"https://127.0.0.1:8936", "https://127.0.0.1:8937", "https://127.0.0.1:8938"})
 
@@ -95,6 +95,7 @@ type LivepeerEthClient interface {
 	CancelUnlock() (*types.Transaction, error)
 	Withdraw() (*types.Transaction, error)
 	RedeemWinningTicket(ticket *pm.Ticket, sig []byte, recipientRand *big.Int) (*types.Transaction, error)
+	BatchRedeemWinningTickets(tickets []*pm.Ticket, sigs [][]byte, recipientRands []*big.Int) (*types.Transaction, error)
 	IsUsedTicket(ticket *pm.Ticket) (bool, error)
 	GetSenderInfo(addr ethcommon.Address) (*pm.SenderInfo, error)
 	UnlockPeriod() (*big.Int, error)
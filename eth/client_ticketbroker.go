@@ -42,25 +42,45 @@ func (c *client) FundReserve(amount *big.Int) (*types.Transaction, error) {
 // RedeemWinningTicket submits a ticket to be validated by the broker and if a valid winning ticket
 // the broker pays the ticket's face value to the ticket's recipient
 func (c *client) RedeemWinningTicket(ticket *pm.Ticket, sig []byte, recipientRand *big.Int) (*types.Transaction, error) {
-	var recipientRandHash [32]byte
-	copy(recipientRandHash[:], ticket.RecipientRandHash.Bytes()[:32])
-
 	return c.ticketBroker.RedeemWinningTicket(
 		c.transactOpts(),
-		contracts.MTicketBrokerCoreTicket{
-			Recipient:         ticket.Recipient,
-			Sender:            ticket.Sender,
-			FaceValue:         ticket.FaceValue,
-			WinProb:           ticket.WinProb,
-			SenderNonce:       new(big.Int).SetUint64(uint64(ticket.SenderNonce)),
-			RecipientRandHash: recipientRandHash,
-			AuxData:           ticket.AuxData(),
-		},
+		contractTicket(ticket),
 		sig,
 		recipientRand,
 	)
 }
 
+// BatchRedeemWinningTickets submits multiple tickets to be validated by the broker in a single transaction
+// The broker pays the face value of each valid winning ticket to the ticket's recipient
+func (c *client) BatchRedeemWinningTickets(tickets []*pm.Ticket, sigs [][]byte, recipientRands []*big.Int) (*types.Transaction, error) {
+	contractTickets := make([]contracts.MTicketBrokerCoreTicket, len(tickets))
+	for i, ticket := range tickets {
+		contractTickets[i] = contractTicket(ticket)
+	}
+
+	return c.ticketBroker.BatchRedeemWinningTickets(
+		c.transactOpts(),
+		contractTickets,
+		sigs,
+		recipientRands,
+	)
+}
+
+func contractTicket(ticket *pm.Ticket) contracts.MTicketBrokerCoreTicket {
+	var recipientRandHash [32]byte
+	copy(recipientRandHash[:], ticket.RecipientRandHash.Bytes()[:32])
+
+	return contracts.MTicketBrokerCoreTicket{
+		Recipient:         ticket.Recipient,
+		Sender:            ticket.Sender,
+		FaceValue:         ticket.FaceValue,
+		WinProb:           ticket.WinProb,
+		SenderNonce:       new(big.Int).SetUint64(uint64(ticket.SenderNonce)),
+		RecipientRandHash: recipientRandHash,
+		AuxData:           ticket.AuxData(),
+	}
+}
+
 // GetSenderInfo returns the info for a sender
 func (c *client) GetSenderInfo(addr ethcommon.Address) (*pm.SenderInfo, error) {
 	info, err := c.ticketBroker.GetSenderInfo(c.callOpts(), addr)
@@ -390,6 +390,9 @@ func (e *StubClient) Withdraw() (*types.Transaction, error) {
 func (e *StubClient) RedeemWinningTicket(ticket *pm.Ticket, sig []byte, recipientRand *big.Int) (*types.Transaction, error) {
 	return nil, nil
 }
+func (e *StubClient) BatchRedeemWinningTickets(tickets []*pm.Ticket, sigs [][]byte, recipientRands []*big.Int) (*types.Transaction, error) {
+	return nil, nil
+}
 func (e *StubClient) IsUsedTicket(ticket *pm.Ticket) (bool, error) {
 	return true, nil
 }
@@ -57,6 +57,10 @@ type Broker interface {
 	// the broker pays the ticket's face value to the ticket's recipient
 	RedeemWinningTicket(ticket *Ticket, sig []byte, recipientRand *big.Int) (*types.Transaction, error)
 
+	// BatchRedeemWinningTickets submits multiple tickets to be validated by the broker in a single transaction
+	// and the broker pays the face value of each valid winning ticket to the ticket's recipient
+	BatchRedeemWinningTickets(tickets []*Ticket, sigs [][]byte, recipientRands []*big.Int) (*types.Transaction, error)
+
 	// IsUsedTicket checks if a ticket has been used
 	IsUsedTicket(ticket *Ticket) (bool, error)
 
@@ -19,11 +19,15 @@ type RedeemableEmitter interface {
 	Redeemable() chan *redemption
 }
 
+// redemption holds one or more tickets that should be redeemed in a single transaction
 type redemption struct {
-	SignedTicket *SignedTicket
-	resCh        chan struct {
-		txHash ethcommon.Hash
-		err    error
+	SignedTickets []*SignedTicket
+	// The result of the redemption
+	// skipped contains the tickets that were left out of the redemption and should remain queued
+	resCh chan struct {
+		txHash  ethcommon.Hash
+		skipped []*SignedTicket
+		err     error
 	}
 }
 
@@ -44,17 +48,26 @@ type ticketQueue struct {
 	sender ethcommon.Address
 	store  TicketStore
 
+	// batchSize is the max number of tickets sent for redemption at once
+	batchSize int
+
 	quit chan struct{}
 
 	mu sync.Mutex
 }
 
 func newTicketQueue(sender ethcommon.Address, sm *LocalSenderMonitor) *ticketQueue {
+	batchSize := 1
+	if sm.cfg != nil && sm.cfg.RedeemBatchSize > 1 {
+		batchSize = sm.cfg.RedeemBatchSize
+	}
+
 	return &ticketQueue{
 		tm:         sm.tm,
 		redeemable: make(chan *redemption),
 		store:      sm.ticketStore,
 		sender:     sender,
+		batchSize:  batchSize,
 		quit:       make(chan struct{}),
 	}
 }
@@ -121,46 +134,90 @@ func (q *ticketQueue) handleBlockEvent(latestL1Block *big.Int) {
 		glog.Errorf("Error getting queue length err=%q", err)
 		return
 	}
+	// offset is the number of selected tickets that remain in the store after being handled during
+	// this block. Subsequent selections skip past them so that they do not block newer tickets
+	offset := 0
+	limit := q.batchSize
 	for i := 0; i < int(numTickets); i++ {
-		nextTicket, err := q.store.SelectEarliestWinningTicket(q.sender, new(big.Int).Sub(q.tm.LastInitializedRound(), big.NewInt(ticketValidityPeriod)).Int64())
+		nextTickets, err := q.store.SelectEarliestWinningTickets(q.sender, new(big.Int).Sub(q.tm.LastInitializedRound(), big.NewInt(ticketValidityPeriod)).Int64(), limit, offset)
 		if err != nil {
-			glog.Errorf("Unable to select earliest winning ticket err=%q", err)
+			glog.Errorf("Unable to select earliest winning tickets err=%q", err)
 			return
 		}
-		if nextTicket == nil {
+		if len(nextTickets) == 0 {
 			return
 		}
-		if !q.isRecipientActive(nextTicket.Recipient) {
-			glog.V(5).Infof("Ticket recipient is not active in this round, cannot redeem ticket recipient=%v", nextTicket.Recipient.Hex())
+
+		var tickets []*SignedTicket
+		for _, ticket := range nextTickets {
+			if !q.isRecipientActive(ticket.Recipient) {
+				glog.V(5).Infof("Ticket recipient is not active in this round, cannot redeem ticket recipient=%v", ticket.Recipient.Hex())
+				offset++
+				continue
+			}
+			if ticket.ParamsExpirationBlock.Cmp(latestL1Block) > 0 {
+				offset++
+				continue
+			}
+			tickets = append(tickets, ticket)
+		}
+		if len(tickets) == 0 {
 			continue
 		}
-		if nextTicket.ParamsExpirationBlock.Cmp(latestL1Block) <= 0 {
-			resCh := make(chan struct {
-				txHash ethcommon.Hash
-				err    error
-			})
-
-			q.redeemable <- &redemption{nextTicket, resCh}
-			select {
-			case res := <-resCh:
-				// after receiving the response we can close the channel so it can be GC'd
-				close(resCh)
-				if res.err != nil {
-					glog.Errorf("Error redeeming err=%q", res.err)
-					// If the error is non-retryable then we mark the ticket as redeemed
-					if !isNonRetryableTicketErr(res.err) {
-						continue
-					}
+
+		resCh := make(chan struct {
+			txHash  ethcommon.Hash
+			skipped []*SignedTicket
+			err     error
+		})
+
+		q.redeemable <- &redemption{tickets, resCh}
+		select {
+		case res := <-resCh:
+			// after receiving the response we can close the channel so it can be GC'd
+			close(resCh)
+			if res.err == errRedemptionDeferred {
+				// Try again on the next block
+				glog.V(5).Infof("Deferring ticket redemption sender=%v numTickets=%d", q.sender.Hex(), len(tickets))
+				return
+			}
+			if res.err != nil {
+				glog.Errorf("Error redeeming err=%q", res.err)
+				// If the error is non-retryable then we mark the ticket as redeemed
+				if !isNonRetryableTicketErr(res.err) {
+					offset += len(tickets)
+					continue
 				}
-				if err := q.store.MarkWinningTicketRedeemed(nextTicket, res.txHash); err != nil {
-					glog.Error(err)
+			}
+			for _, ticket := range tickets {
+				if includesTicket(res.skipped, ticket) {
 					continue
 				}
-			case <-q.quit:
-				return
+				if err := q.store.MarkWinningTicketRedeemed(ticket, res.txHash); err != nil {
+					glog.Error(err)
+				}
+			}
+			if res.err != nil && len(tickets) > 1 {
+				// The tickets left over from a failed batch are redeemed one at a time for the rest of this
+				// block so that a single invalid ticket cannot fail them again
+				limit = 1
+				continue
 			}
+			// Skipped tickets remain in the store
+			offset += len(res.skipped)
+		case <-q.quit:
+			return
+		}
+	}
+}
+
+func includesTicket(tickets []*SignedTicket, ticket *SignedTicket) bool {
+	for _, t := range tickets {
+		if t == ticket {
+			return true
 		}
 	}
+	return false
 }
 
 func isNonRetryableTicketErr(err error) bool {
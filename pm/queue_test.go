@@ -57,9 +57,10 @@ func (qc *queueConsumer) Wait(num int, e RedeemableEmitter, done chan struct{})
 			qc.redeemable = append(qc.redeemable, ticket)
 			qc.mu.Unlock()
 			ticket.resCh <- struct {
-				txHash ethcommon.Hash
-				err    error
-			}{ticket.SignedTicket.Hash(), qc.redemptionErr}
+				txHash  ethcommon.Hash
+				skipped []*SignedTicket
+				err     error
+			}{ticket.SignedTickets[0].Hash(), nil, qc.redemptionErr}
 		}
 	}
 	done <- struct{}{}
@@ -123,11 +124,97 @@ func TestTicketQueueLoop(t *testing.T) {
 	// in order
 	redeemable := qc.Redeemable()
 	for i := 0; i < numTickets; i++ {
-		assert.Equal(uint32(i), redeemable[i].SignedTicket.SenderNonce)
-		assert.True(ts.submitted[fmt.Sprintf("%x", redeemable[i].SignedTicket.Sig)])
+		assert.Equal(uint32(i), redeemable[i].SignedTickets[0].SenderNonce)
+		assert.True(ts.submitted[fmt.Sprintf("%x", redeemable[i].SignedTickets[0].Sig)])
 	}
 }
 
+func TestTicketQueueLoop_Batch(t *testing.T) {
+	assert := assert.New(t)
+
+	sender := RandAddress()
+	ts := newStubTicketStore()
+	tm := &stubTimeManager{round: big.NewInt(100)}
+	sm := &LocalSenderMonitor{
+		cfg:         &LocalSenderMonitorConfig{RedeemBatchSize: 4},
+		ticketStore: ts,
+		tm:          tm,
+	}
+
+	q := newTicketQueue(sender, sm)
+	q.Start()
+	defer q.Stop()
+
+	numTickets := 10
+	for i := 0; i < numTickets; i++ {
+		q.Add(defaultSignedTicket(sender, uint32(i)))
+	}
+
+	qc := &queueConsumer{}
+	done := make(chan struct{})
+	// 10 tickets should be sent in batches of 4, 4 and 2
+	go qc.Wait(3, q, done)
+	time.Sleep(time.Millisecond * 20)
+
+	tm.blockNumSink <- big.NewInt(1)
+	<-done
+	time.Sleep(20 * time.Millisecond)
+
+	qlen, err := q.Length()
+	assert.Nil(err)
+	assert.Equal(0, qlen)
+
+	redeemable := qc.Redeemable()
+	assert.Len(redeemable[0].SignedTickets, 4)
+	assert.Len(redeemable[1].SignedTickets, 4)
+	assert.Len(redeemable[2].SignedTickets, 2)
+	nonce := uint32(0)
+	for _, red := range redeemable {
+		for _, ticket := range red.SignedTickets {
+			assert.Equal(nonce, ticket.SenderNonce)
+			assert.True(ts.submitted[fmt.Sprintf("%x", ticket.Sig)])
+			nonce++
+		}
+	}
+}
+
+func TestTicketQueueLoop_RedemptionDeferred(t *testing.T) {
+	assert := assert.New(t)
+
+	sender := RandAddress()
+	ts := newStubTicketStore()
+	tm := &stubTimeManager{round: big.NewInt(100)}
+	sm := &LocalSenderMonitor{
+		ticketStore: ts,
+		tm:          tm,
+	}
+
+	q := newTicketQueue(sender, sm)
+	q.Start()
+	defer q.Stop()
+
+	numTickets := 3
+	for i := 0; i < numTickets; i++ {
+		q.Add(defaultSignedTicket(sender, uint32(i)))
+	}
+
+	qc := &queueConsumer{redemptionErr: errRedemptionDeferred}
+	done := make(chan struct{})
+	go qc.Wait(1, q, done)
+	time.Sleep(time.Millisecond * 20)
+
+	tm.blockNumSink <- big.NewInt(1)
+	<-done
+	time.Sleep(20 * time.Millisecond)
+
+	// A deferred redemption stops processing the queue until the next block
+	// and does not mark any tickets as redeemed
+	qlen, err := q.Length()
+	assert.Nil(err)
+	assert.Equal(numTickets, qlen)
+	assert.Len(qc.Redeemable(), 1)
+}
+
 func TestTicketQueueLoop_IsNonRetryableTicketErr_MarkAsRedeemed(t *testing.T) {
 	assert := assert.New(t)
 
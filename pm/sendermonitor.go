@@ -20,6 +20,9 @@ import (
 // pending amount to be ignored when calculating the sender's max float
 const minDepositPendingRatio = 3.0
 
+// errRedemptionDeferred is returned when a ticket redemption is postponed because the gas price is too high
+var errRedemptionDeferred = errors.New("ticket redemption deferred")
+
 // unixNow returns the current unix time
 // This is a wrapper function that can be stubbed in tests
 var unixNow = func() int64 {
@@ -59,6 +62,11 @@ type remoteSender struct {
 	done chan struct{}
 
 	lastAccess int64
+
+	// redeemDeferredAt is the time at which ticket redemption was first
+	// deferred because the gas price rose above the max gas price
+	// It is reset once the gas price drops back to or below the max gas price
+	redeemDeferredAt time.Time
 }
 
 type LocalSenderMonitorConfig struct {
@@ -73,6 +81,13 @@ type LocalSenderMonitorConfig struct {
 	RedeemGas       int
 	SuggestGasPrice func(context.Context) (*big.Int, error)
 	RPCTimeout      time.Duration
+
+	// The max number of winning tickets from a sender to redeem in a single transaction
+	RedeemBatchSize int
+	// Ticket redemption is deferred while the gas price is above RedeemMaxGasPrice
+	// for at most RedeemMaxDelay. A nil RedeemMaxGasPrice disables deferral
+	RedeemMaxGasPrice *big.Int
+	RedeemMaxDelay    time.Duration
 }
 
 type LocalSenderMonitor struct {
@@ -288,12 +303,14 @@ func (sm *LocalSenderMonitor) startTicketQueueConsumerLoop(queue *ticketQueue, d
 	for {
 		select {
 		case red := <-queue.Redeemable():
-			tx, err := sm.redeemWinningTicket(red.SignedTicket)
+			tx, skipped, err := sm.redeemWinningTickets(red.SignedTickets)
 			res := struct {
-				txHash ethcommon.Hash
-				err    error
+				txHash  ethcommon.Hash
+				skipped []*SignedTicket
+				err     error
 			}{
 				ethcommon.Hash{},
+				skipped,
 				err,
 			}
 			// FIXME: If there are replacement txs then tx.Hash() could be different
@@ -352,53 +369,86 @@ func (sm *LocalSenderMonitor) cleanup() {
 	}
 }
 
+// redeemWinningTickets submits a redemption for one or more winning tickets from the same sender.
+// A single ticket is redeemed using RedeemWinningTicket() and multiple tickets are redeemed
+// in a single transaction using BatchRedeemWinningTickets()
 // Returns a non-nil tx if one is sent. Otherwise, returns a nil tx
-func (sm *LocalSenderMonitor) redeemWinningTicket(ticket *SignedTicket) (*types.Transaction, error) {
-	availableFunds, err := sm.availableFunds(ticket.Sender)
+// Also returns the tickets that were left out of the redemption and should remain queued
+func (sm *LocalSenderMonitor) redeemWinningTickets(tickets []*SignedTicket) (*types.Transaction, []*SignedTicket, error) {
+	sender := tickets[0].Sender
+
+	ctx, cancel := context.WithTimeout(context.Background(), sm.cfg.RPCTimeout)
+	gasPrice, err := sm.cfg.SuggestGasPrice(ctx)
 	if err != nil {
-		return nil, err
+		cancel()
+		return nil, nil, err
+	}
+	cancel()
+
+	if sm.deferRedemption(sender, gasPrice) {
+		return nil, nil, errRedemptionDeferred
 	}
 
-	// Fail early if ticket is used
-	used, err := sm.broker.IsUsedTicket(ticket.Ticket)
+	availableFunds, err := sm.availableFunds(sender)
 	if err != nil {
-		if monitor.Enabled {
-			monitor.TicketRedemptionError(ticket.Sender.Hex())
-		}
-		return nil, err
+		return nil, nil, err
 	}
-	if used {
-		if monitor.Enabled {
-			monitor.TicketRedemptionError(ticket.Sender.Hex())
+
+	// Fail early if all tickets are used
+	// Tickets that do not cover their share of the redemption tx cost are left out of
+	// the redemption so they can be redeemed later when the gas price is lower
+	ticketTxCost := new(big.Int).Mul(big.NewInt(int64(sm.cfg.RedeemGas)), gasPrice)
+	var redeemable, skipped []*SignedTicket
+	for _, ticket := range tickets {
+		used, err := sm.broker.IsUsedTicket(ticket.Ticket)
+		if err != nil {
+			if monitor.Enabled {
+				monitor.TicketRedemptionError(sender.Hex())
+			}
+			return nil, nil, err
+		}
+		if used {
+			if monitor.Enabled {
+				monitor.TicketRedemptionError(sender.Hex())
+			}
+			continue
+		}
+		if ticket.FaceValue.Cmp(ticketTxCost) <= 0 {
+			skipped = append(skipped, ticket)
+			continue
 		}
-		return nil, errIsUsedTicket
+		redeemable = append(redeemable, ticket)
 	}
-
-	ctx, cancel := context.WithTimeout(context.Background(), sm.cfg.RPCTimeout)
-	gasPrice, err := sm.cfg.SuggestGasPrice(ctx)
-	if err != nil {
-		cancel()
-		return nil, err
+	if len(redeemable) == 0 && len(skipped) == 0 {
+		return nil, nil, errIsUsedTicket
 	}
-	cancel()
 
 	// We only submit a redemption if availableFunds covers the redemption tx cost
 	// Otherwise, we return an error so we can try the redemption later
-	txCost := new(big.Int).Mul(big.NewInt(int64(sm.cfg.RedeemGas)), gasPrice)
+	numRedeemable := len(redeemable)
+	if numRedeemable == 0 {
+		numRedeemable = 1
+	}
+	txCost := new(big.Int).Mul(ticketTxCost, big.NewInt(int64(numRedeemable)))
 	if availableFunds.Cmp(txCost) <= 0 {
-		return nil, errors.New("insufficient sender funds for redeem tx cost")
+		return nil, nil, errors.New("insufficient sender funds for redeem tx cost")
+	}
+	if len(redeemable) == 0 {
+		return nil, nil, errors.New("insufficient ticket face value for redeem tx cost")
 	}
-	if ticket.FaceValue.Cmp(txCost) <= 0 {
-		return nil, errors.New("insufficient ticket face value for redeem tx cost")
+
+	faceValue := big.NewInt(0)
+	for _, ticket := range redeemable {
+		faceValue.Add(faceValue, ticket.FaceValue)
 	}
 
-	// Subtract the ticket face value from the sender's current max float
+	// Subtract the ticket face values from the sender's current max float
 	// This amount will be considered pending until the ticket redemption
 	// transaction confirms on-chain
-	sm.subFloat(ticket.Ticket.Sender, ticket.Ticket.FaceValue)
+	sm.subFloat(sender, faceValue)
 
 	defer func() {
-		// Add the ticket face value back to the sender's current max float
+		// Add the ticket face values back to the sender's current max float
 		// This amount is no longer considered pending since the ticket
 		// redemption transaction either confirmed on-chain or was not
 		// submitted at all
@@ -407,37 +457,96 @@ func (sm *LocalSenderMonitor) redeemWinningTicket(ticket *SignedTicket) (*types.
 		// was actually successfully redeemed in order to take into account
 		// the case where the ticket was not redeemed for its full face value
 		// because the reserve was insufficient
-		if err := sm.addFloat(ticket.Ticket.Sender, ticket.Ticket.FaceValue); err != nil {
+		if err := sm.addFloat(sender, faceValue); err != nil {
 			glog.Error(err)
 		}
 	}()
 
 	// Assume that that this call will return immediately if there
 	// is an error in transaction submission
-	tx, err := sm.broker.RedeemWinningTicket(ticket.Ticket, ticket.Sig, ticket.RecipientRand)
+	var tx *types.Transaction
+	if len(redeemable) == 1 {
+		ticket := redeemable[0]
+		tx, err = sm.broker.RedeemWinningTicket(ticket.Ticket, ticket.Sig, ticket.RecipientRand)
+	} else {
+		batchTickets := make([]*Ticket, len(redeemable))
+		sigs := make([][]byte, len(redeemable))
+		recipientRands := make([]*big.Int, len(redeemable))
+		for i, ticket := range redeemable {
+			batchTickets[i] = ticket.Ticket
+			sigs[i] = ticket.Sig
+			recipientRands[i] = ticket.RecipientRand
+		}
+		tx, err = sm.broker.BatchRedeemWinningTickets(batchTickets, sigs, recipientRands)
+	}
 	if err != nil {
 		if monitor.Enabled {
-			monitor.TicketRedemptionError(ticket.Sender.Hex())
+			monitor.TicketRedemptionError(sender.Hex())
 		}
-		return nil, err
+		return nil, skipped, err
 	}
 
 	// Wait for transaction to confirm
 	if err := sm.broker.CheckTx(tx); err != nil {
 		if monitor.Enabled {
-			monitor.TicketRedemptionError(ticket.Sender.Hex())
+			monitor.TicketRedemptionError(sender.Hex())
+		}
+		if len(redeemable) > 1 {
+			// A failed batch does not tell which ticket caused the failure so only the tickets
+			// that were used are considered redeemed and the rest are left to be redeemed again
+			for _, ticket := range redeemable {
+				used, usedErr := sm.broker.IsUsedTicket(ticket.Ticket)
+				if usedErr != nil {
+					glog.Errorf("Error checking if ticket is used sender=%v err=%q", sender.Hex(), usedErr)
+				}
+				if usedErr != nil || !used {
+					skipped = append(skipped, ticket)
+				}
+			}
 		}
 		// Return tx so caller can utilize the tx if it fails
-		return tx, err
+		return tx, skipped, err
 	}
 
 	if monitor.Enabled {
 		// TODO(yondonfu): Handle case where < ticket.FaceValue is actually
 		// redeemed i.e. if sender reserve cannot cover the full ticket.FaceValue
-		monitor.ValueRedeemed(ticket.Sender.Hex(), ticket.Ticket.FaceValue)
+		for _, ticket := range redeemable {
+			monitor.ValueRedeemed(sender.Hex(), ticket.Ticket.FaceValue)
+		}
+	}
+
+	return tx, skipped, nil
+}
+
+// deferRedemption returns true if a redemption for a sender should be postponed because the gas price
+// is above RedeemMaxGasPrice. Once redemptions for a sender have been deferred for RedeemMaxDelay, they
+// are no longer deferred until the gas price drops back to or below RedeemMaxGasPrice
+func (sm *LocalSenderMonitor) deferRedemption(addr ethcommon.Address, gasPrice *big.Int) bool {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+
+	// Do not use ensureCache() here since a redemption should neither refresh a sender's last access
+	// time nor re-cache a sender that has been cleaned up
+	rs, ok := sm.senders[addr]
+	if !ok {
+		return false
+	}
+	if sm.cfg.RedeemMaxGasPrice == nil || gasPrice.Cmp(sm.cfg.RedeemMaxGasPrice) <= 0 {
+		rs.redeemDeferredAt = time.Time{}
+		return false
+	}
+
+	if rs.redeemDeferredAt.IsZero() {
+		rs.redeemDeferredAt = time.Now()
+	}
+
+	if time.Since(rs.redeemDeferredAt) >= sm.cfg.RedeemMaxDelay {
+		glog.V(6).Infof("Redeeming tickets for sender=%v above max gas price gasPrice=%v maxGasPrice=%v after deferring for %v", addr.Hex(), gasPrice, sm.cfg.RedeemMaxGasPrice, time.Since(rs.redeemDeferredAt))
+		return false
 	}
 
-	return tx, nil
+	return true
 }
 
 // SubscribeMaxFloatChange notifies subcribers when the max float for a sender has changed
@@ -629,20 +629,20 @@ func TestRedeemWinningTicket_IsUsedTicket(t *testing.T) {
 
 	// test error
 	b.isUsedErr = errors.New("isUsed error")
-	tx, err := sm.redeemWinningTicket(signedT)
+	tx, _, err := sm.redeemWinningTickets([]*SignedTicket{signedT})
 	assert.Nil(tx)
 	assert.EqualError(err, b.isUsedErr.Error())
 
 	// test used
 	b.isUsedErr = nil
 	b.usedTickets[signedT.Hash()] = true
-	tx, err = sm.redeemWinningTicket(signedT)
+	tx, _, err = sm.redeemWinningTickets([]*SignedTicket{signedT})
 	assert.Nil(tx)
 	assert.EqualError(err, errIsUsedTicket.Error())
 
 	// test not used
 	b.usedTickets[signedT.Hash()] = false
-	tx, err = sm.redeemWinningTicket(signedT)
+	tx, _, err = sm.redeemWinningTickets([]*SignedTicket{signedT})
 	assert.Nil(err)
 	assert.NotNil(tx)
 	assert.True(b.IsUsedTicket(signedT.Ticket))
@@ -670,7 +670,7 @@ func TestRedeemWinningTicket_CheckAvailableFundsAndFaceValue(t *testing.T) {
 
 	// Trigger availableFunds() error
 	smgr.err = errors.New("GetSenderInfo() error")
-	_, err := sm.redeemWinningTicket(signedT)
+	_, _, err := sm.redeemWinningTickets([]*SignedTicket{signedT})
 	assert.EqualError(err, smgr.err.Error())
 
 	smgr.err = nil
@@ -679,7 +679,7 @@ func TestRedeemWinningTicket_CheckAvailableFundsAndFaceValue(t *testing.T) {
 	gasPriceErr := errors.New("SuggestGasPrice() error")
 	cfg.SuggestGasPrice = func(ctx context.Context) (*big.Int, error) { return nil, gasPriceErr }
 	sm = NewSenderMonitor(cfg, b, smgr, tm, ts)
-	_, err = sm.redeemWinningTicket(signedT)
+	_, _, err = sm.redeemWinningTickets([]*SignedTicket{signedT})
 	assert.EqualError(err, gasPriceErr.Error())
 
 	// Trigger SuggestGasPrice() timeout
@@ -695,14 +695,14 @@ func TestRedeemWinningTicket_CheckAvailableFundsAndFaceValue(t *testing.T) {
 		return nil, errors.New("incorrect timeout error")
 	}
 	sm = NewSenderMonitor(cfg, b, smgr, tm, ts)
-	_, err = sm.redeemWinningTicket(signedT)
+	_, _, err = sm.redeemWinningTickets([]*SignedTicket{signedT})
 	assert.EqualError(err, timeoutErr.Error())
 
 	// Trigger insufficient funds to cover redeem tx cost error when availableFunds < txCost
 	cfg.RedeemGas = 1
 	cfg.SuggestGasPrice = func(ctx context.Context) (*big.Int, error) { return big.NewInt(1000000000), nil }
 	sm = NewSenderMonitor(cfg, b, smgr, tm, ts)
-	_, err = sm.redeemWinningTicket(signedT)
+	_, _, err = sm.redeemWinningTickets([]*SignedTicket{signedT})
 	assert.Contains(err.Error(), "insufficient sender funds")
 
 	// Trigger insufficient funds to cover redeem tx cost error when availableFunds = txCost
@@ -711,7 +711,7 @@ func TestRedeemWinningTicket_CheckAvailableFundsAndFaceValue(t *testing.T) {
 	cfg.RedeemGas = 1
 	cfg.SuggestGasPrice = func(ctx context.Context) (*big.Int, error) { return funds, nil }
 	sm = NewSenderMonitor(cfg, b, smgr, tm, ts)
-	_, err = sm.redeemWinningTicket(signedT)
+	_, _, err = sm.redeemWinningTickets([]*SignedTicket{signedT})
 	assert.Contains(err.Error(), "insufficient sender funds")
 
 	// Trigger insufficient face value to cover redeem tx cost error when face value < txCost
@@ -721,20 +721,20 @@ func TestRedeemWinningTicket_CheckAvailableFundsAndFaceValue(t *testing.T) {
 	badSignedT := defaultSignedTicket(addr, uint32(0))
 	badSignedT.FaceValue = new(big.Int).Sub(txCost, big.NewInt(1))
 	sm = NewSenderMonitor(cfg, b, smgr, tm, ts)
-	_, err = sm.redeemWinningTicket(signedT)
+	_, _, err = sm.redeemWinningTickets([]*SignedTicket{signedT})
 	assert.Contains(err.Error(), "insufficient ticket face value")
 
 	// Trigger insufficient face value to cover redeem tx cost error when face value = txCost
 	badSignedT.FaceValue = txCost
 	sm = NewSenderMonitor(cfg, b, smgr, tm, ts)
-	_, err = sm.redeemWinningTicket(signedT)
+	_, _, err = sm.redeemWinningTickets([]*SignedTicket{signedT})
 	assert.Contains(err.Error(), "insufficient ticket face value")
 
 	// Pass available funds and face value check when availableFunds > txCost and face value > txCost
 	cfg.RedeemGas = 0
 	cfg.SuggestGasPrice = func(ctx context.Context) (*big.Int, error) { return big.NewInt(0), nil }
 	sm = NewSenderMonitor(cfg, b, smgr, tm, ts)
-	tx, err := sm.redeemWinningTicket(signedT)
+	tx, _, err := sm.redeemWinningTickets([]*SignedTicket{signedT})
 	assert.Nil(err)
 	assert.NotNil(tx)
 }
@@ -762,7 +762,7 @@ func TestRedeemWinningTicket_SingleTicket_RedeemError(t *testing.T) {
 	signedT := defaultSignedTicket(addr, uint32(0))
 
 	b.redeemShouldFail = true
-	tx, err := sm.redeemWinningTicket(signedT)
+	tx, _, err := sm.redeemWinningTickets([]*SignedTicket{signedT})
 	assert.EqualError(err, "stub broker redeem error")
 	assert.Nil(tx)
 	used, err := b.IsUsedTicket(signedT.Ticket)
@@ -770,6 +770,297 @@ func TestRedeemWinningTicket_SingleTicket_RedeemError(t *testing.T) {
 	assert.False(used)
 }
 
+func TestRedeemWinningTickets_Batch(t *testing.T) {
+	cfg, b, smgr, tm := localSenderMonitorFixture()
+	addr := RandAddress()
+	smgr.info[addr] = &SenderInfo{
+		Deposit:       big.NewInt(500),
+		WithdrawRound: big.NewInt(0),
+		Reserve: &ReserveInfo{
+			FundsRemaining:        big.NewInt(1000),
+			ClaimedInCurrentRound: big.NewInt(0),
+		},
+	}
+
+	ts := newStubTicketStore()
+	smgr.claimedReserve[addr] = big.NewInt(100)
+	sm := NewSenderMonitor(cfg, b, smgr, tm, ts)
+	sm.Start()
+	defer sm.Stop()
+
+	assert := assert.New(t)
+
+	signedT0 := defaultSignedTicket(addr, uint32(0))
+	signedT1 := defaultSignedTicket(addr, uint32(1))
+	signedT2 := defaultSignedTicket(addr, uint32(2))
+
+	// Used tickets are skipped
+	b.usedTickets[signedT2.Hash()] = true
+
+	tx, _, err := sm.redeemWinningTickets([]*SignedTicket{signedT0, signedT1, signedT2})
+	assert.Nil(err)
+	assert.NotNil(tx)
+	assert.Equal(1, b.batchRedemptions)
+	assert.True(b.IsUsedTicket(signedT0.Ticket))
+	assert.True(b.IsUsedTicket(signedT1.Ticket))
+
+	// All tickets used
+	tx, _, err = sm.redeemWinningTickets([]*SignedTicket{signedT0, signedT1, signedT2})
+	assert.Nil(tx)
+	assert.EqualError(err, errIsUsedTicket.Error())
+	assert.Equal(1, b.batchRedemptions)
+
+	// Tickets with a face value that does not cover their share of the tx cost are skipped
+	cfg.RedeemGas = 1
+	cfg.SuggestGasPrice = func(ctx context.Context) (*big.Int, error) { return big.NewInt(10), nil }
+	sm = NewSenderMonitor(cfg, b, smgr, tm, ts)
+	signedT3 := defaultSignedTicket(addr, uint32(3))
+	signedT4 := defaultSignedTicket(addr, uint32(4))
+	signedT5 := defaultSignedTicket(addr, uint32(5))
+	signedT4.FaceValue = big.NewInt(10)
+	tx, skipped, err := sm.redeemWinningTickets([]*SignedTicket{signedT3, signedT4, signedT5})
+	assert.Nil(err)
+	assert.NotNil(tx)
+	assert.Equal([]*SignedTicket{signedT4}, skipped)
+	assert.Equal(2, b.batchRedemptions)
+	assert.True(b.IsUsedTicket(signedT3.Ticket))
+	assert.False(b.IsUsedTicket(signedT4.Ticket))
+	assert.True(b.IsUsedTicket(signedT5.Ticket))
+
+	// Error if no ticket covers its share of the tx cost
+	tx, _, err = sm.redeemWinningTickets([]*SignedTicket{signedT4})
+	assert.Nil(tx)
+	assert.Contains(err.Error(), "insufficient ticket face value")
+
+	// Sender funds must cover the tx cost of the whole batch
+	funds, err := sm.availableFunds(addr)
+	require.Nil(t, err)
+	ticketTxCost := new(big.Int).Add(new(big.Int).Div(funds, big.NewInt(2)), big.NewInt(1))
+	cfg.SuggestGasPrice = func(ctx context.Context) (*big.Int, error) { return ticketTxCost, nil }
+	sm = NewSenderMonitor(cfg, b, smgr, tm, ts)
+	signedT6 := defaultSignedTicket(addr, uint32(6))
+	signedT7 := defaultSignedTicket(addr, uint32(7))
+	signedT6.FaceValue = funds
+	signedT7.FaceValue = funds
+	tx, _, err = sm.redeemWinningTickets([]*SignedTicket{signedT6, signedT7})
+	assert.Nil(tx)
+	assert.Contains(err.Error(), "insufficient sender funds")
+}
+
+func TestRedeemWinningTickets_Batch_CheckTxError(t *testing.T) {
+	cfg, b, smgr, tm := localSenderMonitorFixture()
+	addr := RandAddress()
+	smgr.info[addr] = &SenderInfo{
+		Deposit:       big.NewInt(500),
+		WithdrawRound: big.NewInt(0),
+		Reserve: &ReserveInfo{
+			FundsRemaining:        big.NewInt(1000),
+			ClaimedInCurrentRound: big.NewInt(0),
+		},
+	}
+
+	ts := newStubTicketStore()
+	smgr.claimedReserve[addr] = big.NewInt(100)
+	sm := NewSenderMonitor(cfg, b, smgr, tm, ts)
+	sm.Start()
+	defer sm.Stop()
+
+	assert := assert.New(t)
+
+	signedT0 := defaultSignedTicket(addr, uint32(0))
+	signedT1 := defaultSignedTicket(addr, uint32(1))
+	signedT2 := defaultSignedTicket(addr, uint32(2))
+
+	// Tickets that are not used after a failed batch are returned as skipped
+	b.checkTxErr = errors.New("transaction failed")
+	tx, skipped, err := sm.redeemWinningTickets([]*SignedTicket{signedT0, signedT1, signedT2})
+	assert.NotNil(tx)
+	assert.EqualError(err, b.checkTxErr.Error())
+	assert.Equal([]*SignedTicket{signedT0, signedT1, signedT2}, skipped)
+	assert.Equal(1, b.batchRedemptions)
+}
+
+func TestQueueTicketAndSignalNewBlock_Batch_CheckTxError(t *testing.T) {
+	cfg, b, smgr, tm := localSenderMonitorFixture()
+	addr := RandAddress()
+	smgr.info[addr] = &SenderInfo{
+		Deposit:       big.NewInt(500),
+		WithdrawRound: big.NewInt(0),
+		Reserve: &ReserveInfo{
+			FundsRemaining:        big.NewInt(5000),
+			ClaimedInCurrentRound: big.NewInt(0),
+		},
+	}
+	smgr.claimedReserve[addr] = big.NewInt(100)
+	cfg.RedeemBatchSize = 3
+	b.checkTxErr = errors.New("transaction failed")
+
+	ts := newStubTicketStore()
+	sm := NewSenderMonitor(cfg, b, smgr, tm, ts)
+	sm.Start()
+	defer sm.Stop()
+
+	assert := assert.New(t)
+
+	var signedTs []*SignedTicket
+	for i := 0; i < 3; i++ {
+		signedT := defaultSignedTicket(addr, uint32(i))
+		require.Nil(t, sm.QueueTicket(signedT))
+		signedTs = append(signedTs, signedT)
+	}
+	time.Sleep(20 * time.Millisecond)
+
+	// The tickets from the failed batch stay queued and are redeemed one at a time
+	tm.blockNumSink <- big.NewInt(5)
+	time.Sleep(50 * time.Millisecond)
+	qlen, err := sm.senders[addr].queue.Length()
+	assert.Nil(err)
+	assert.Equal(1, qlen)
+	assert.Equal(1, b.batchRedemptions)
+	assert.True(b.IsUsedTicket(signedTs[0].Ticket))
+	assert.True(b.IsUsedTicket(signedTs[1].Ticket))
+	assert.False(b.IsUsedTicket(signedTs[2].Ticket))
+
+	// The remaining ticket is redeemed on the next block
+	tm.blockNumSink <- big.NewInt(6)
+	time.Sleep(50 * time.Millisecond)
+	qlen, err = sm.senders[addr].queue.Length()
+	assert.Nil(err)
+	assert.Equal(0, qlen)
+	assert.True(b.IsUsedTicket(signedTs[2].Ticket))
+}
+
+func TestRedeemWinningTickets_DeferredAboveMaxGasPrice(t *testing.T) {
+	cfg, b, smgr, tm := localSenderMonitorFixture()
+	addr := RandAddress()
+	smgr.info[addr] = &SenderInfo{
+		Deposit:       big.NewInt(500),
+		WithdrawRound: big.NewInt(0),
+		Reserve: &ReserveInfo{
+			FundsRemaining:        big.NewInt(1000),
+			ClaimedInCurrentRound: big.NewInt(0),
+		},
+	}
+	smgr.claimedReserve[addr] = big.NewInt(100)
+
+	gasPrice := big.NewInt(10)
+	cfg.SuggestGasPrice = func(ctx context.Context) (*big.Int, error) { return gasPrice, nil }
+	cfg.RedeemMaxGasPrice = big.NewInt(5)
+	cfg.RedeemMaxDelay = 50 * time.Millisecond
+
+	ts := newStubTicketStore()
+	sm := NewSenderMonitor(cfg, b, smgr, tm, ts)
+	sm.Start()
+	defer sm.Stop()
+
+	assert := assert.New(t)
+
+	signedT := defaultSignedTicket(addr, uint32(0))
+
+	// Redemptions are not deferred for a sender that is not cached
+	assert.False(sm.deferRedemption(addr, gasPrice))
+	assert.Nil(sm.senders[addr])
+
+	sm.mu.Lock()
+	sm.ensureCache(addr)
+	sm.mu.Unlock()
+
+	// Gas price above max gas price defers the redemption
+	tx, _, err := sm.redeemWinningTickets([]*SignedTicket{signedT})
+	assert.Nil(tx)
+	assert.Equal(errRedemptionDeferred, err)
+	assert.False(b.IsUsedTicket(signedT.Ticket))
+
+	// Redemption proceeds once the max delay has elapsed
+	time.Sleep(60 * time.Millisecond)
+	tx, _, err = sm.redeemWinningTickets([]*SignedTicket{signedT})
+	assert.Nil(err)
+	assert.NotNil(tx)
+	assert.True(b.IsUsedTicket(signedT.Ticket))
+
+	// Gas price at or below max gas price does not defer the redemption
+	gasPrice = big.NewInt(5)
+	signedT = defaultSignedTicket(addr, uint32(1))
+	tx, _, err = sm.redeemWinningTickets([]*SignedTicket{signedT})
+	assert.Nil(err)
+	assert.NotNil(tx)
+
+	// No deferral without a max gas price
+	gasPrice = big.NewInt(10)
+	cfg.RedeemMaxGasPrice = nil
+	signedT = defaultSignedTicket(addr, uint32(2))
+	tx, _, err = sm.redeemWinningTickets([]*SignedTicket{signedT})
+	assert.Nil(err)
+	assert.NotNil(tx)
+}
+
+func TestQueueTicketAndSignalNewBlock_RedemptionDeferred(t *testing.T) {
+	cfg, b, smgr, tm := localSenderMonitorFixture()
+	addr := RandAddress()
+	smgr.info[addr] = &SenderInfo{
+		Deposit:       big.NewInt(500),
+		WithdrawRound: big.NewInt(0),
+		Reserve: &ReserveInfo{
+			FundsRemaining:        big.NewInt(5000),
+			ClaimedInCurrentRound: big.NewInt(0),
+		},
+	}
+	smgr.claimedReserve[addr] = big.NewInt(100)
+
+	cfg.RedeemGas = 1
+	cfg.SuggestGasPrice = func(ctx context.Context) (*big.Int, error) { return big.NewInt(10), nil }
+	cfg.RedeemBatchSize = 3
+	cfg.RedeemMaxGasPrice = big.NewInt(5)
+	cfg.RedeemMaxDelay = 50 * time.Millisecond
+
+	ts := newStubTicketStore()
+	sm := NewSenderMonitor(cfg, b, smgr, tm, ts)
+	sm.Start()
+	defer sm.Stop()
+
+	assert := assert.New(t)
+
+	// Ticket with a face value that does not cover the tx cost is queued first so it is part of the first batch
+	badSignedT := defaultSignedTicket(addr, uint32(0))
+	badSignedT.FaceValue = big.NewInt(10)
+	require.Nil(t, sm.QueueTicket(badSignedT))
+
+	// More tickets than fit in a single batch
+	var signedTs []*SignedTicket
+	for i := 1; i <= 5; i++ {
+		signedT := defaultSignedTicket(addr, uint32(i))
+		require.Nil(t, sm.QueueTicket(signedT))
+		signedTs = append(signedTs, signedT)
+	}
+	time.Sleep(20 * time.Millisecond)
+
+	// Gas price above max gas price defers all redemptions
+	tm.blockNumSink <- big.NewInt(5)
+	time.Sleep(20 * time.Millisecond)
+	qlen, err := sm.senders[addr].queue.Length()
+	assert.Nil(err)
+	assert.Equal(6, qlen)
+	assert.Equal(0, b.batchRedemptions)
+
+	// Once the max delay has elapsed all batches are redeemed while the gas price stays high
+	time.Sleep(60 * time.Millisecond)
+	tm.blockNumSink <- big.NewInt(6)
+	time.Sleep(50 * time.Millisecond)
+	qlen, err = sm.senders[addr].queue.Length()
+	assert.Nil(err)
+	assert.Equal(1, qlen)
+	assert.Equal(2, b.batchRedemptions)
+	for _, signedT := range signedTs {
+		assert.True(b.IsUsedTicket(signedT.Ticket))
+	}
+
+	// The skipped ticket remains queued
+	assert.False(b.IsUsedTicket(badSignedT.Ticket))
+	earliest, err := ts.SelectEarliestWinningTicket(addr, badSignedT.CreationRound)
+	assert.Nil(err)
+	assert.Equal(badSignedT, earliest)
+}
+
 func TestRedeemWinningTicket_SingleTicket_CheckTxError(t *testing.T) {
 	cfg, b, smgr, tm := localSenderMonitorFixture()
 	addr := RandAddress()
@@ -793,7 +1084,7 @@ func TestRedeemWinningTicket_SingleTicket_CheckTxError(t *testing.T) {
 
 	signedT := defaultSignedTicket(addr, uint32(0))
 
-	tx, err := sm.redeemWinningTicket(signedT)
+	tx, _, err := sm.redeemWinningTickets([]*SignedTicket{signedT})
 	assert.NotNil(tx)
 	assert.Equal(expErr, err)
 }
@@ -819,7 +1110,7 @@ func TestRedeemWinningTicket_SingleTicket(t *testing.T) {
 
 	signedT := defaultSignedTicket(addr, uint32(0))
 
-	tx, err := sm.redeemWinningTicket(signedT)
+	tx, _, err := sm.redeemWinningTickets([]*SignedTicket{signedT})
 	assert.Nil(err)
 	assert.NotNil(tx)
 
@@ -853,7 +1144,7 @@ func TestRedeemWinningTicket_addFloatError(t *testing.T) {
 	sm.senders[addr].pendingAmount = big.NewInt(-100)
 
 	errLogsBefore := glog.Stats.Error.Lines()
-	tx, err := sm.redeemWinningTicket(signedT)
+	tx, _, err := sm.redeemWinningTickets([]*SignedTicket{signedT})
 	assert.NotNil(tx)
 	errLogsAfter := glog.Stats.Error.Lines()
 	assert.Nil(err)
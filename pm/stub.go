@@ -59,17 +59,34 @@ func (ts *stubTicketStore) StoreWinningTicket(ticket *SignedTicket) error {
 }
 
 func (ts *stubTicketStore) SelectEarliestWinningTicket(sender ethcommon.Address, minCreationRound int64) (*SignedTicket, error) {
+	tickets, err := ts.SelectEarliestWinningTickets(sender, minCreationRound, 1, 0)
+	if err != nil || len(tickets) == 0 {
+		return nil, err
+	}
+	return tickets[0], nil
+}
+
+func (ts *stubTicketStore) SelectEarliestWinningTickets(sender ethcommon.Address, minCreationRound int64, limit, offset int) ([]*SignedTicket, error) {
 	ts.lock.Lock()
 	defer ts.lock.Unlock()
 	if ts.loadShouldFail {
 		return nil, fmt.Errorf("stub TicketStore load error")
 	}
+	var tickets []*SignedTicket
 	for _, t := range ts.tickets[sender] {
-		if !ts.submitted[fmt.Sprintf("%x", t.Sig)] {
-			return t, nil
+		if len(tickets) >= limit {
+			break
+		}
+		if ts.submitted[fmt.Sprintf("%x", t.Sig)] {
+			continue
 		}
+		if offset > 0 {
+			offset--
+			continue
+		}
+		tickets = append(tickets, t)
 	}
-	return nil, nil
+	return tickets, nil
 }
 
 func (ts *stubTicketStore) MarkWinningTicketRedeemed(ticket *SignedTicket, txHash ethcommon.Hash) error {
@@ -147,6 +164,8 @@ type stubBroker struct {
 
 	checkTxErr error
 	isUsedErr  error
+
+	batchRedemptions int
 }
 
 func newStubBroker() *stubBroker {
@@ -193,6 +212,25 @@ func (b *stubBroker) RedeemWinningTicket(ticket *Ticket, sig []byte, recipientRa
 	return types.NewTx(&types.DynamicFeeTx{}), nil
 }
 
+func (b *stubBroker) BatchRedeemWinningTickets(tickets []*Ticket, sigs [][]byte, recipientRands []*big.Int) (*types.Transaction, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.redeemShouldFail {
+		return nil, fmt.Errorf("stub broker redeem error")
+	}
+
+	// A failed batch tx does not redeem any tickets
+	if b.checkTxErr == nil {
+		for _, ticket := range tickets {
+			b.usedTickets[ticket.Hash()] = true
+		}
+	}
+	b.batchRedemptions++
+
+	return types.NewTx(&types.DynamicFeeTx{}), nil
+}
+
 func (b *stubBroker) IsUsedTicket(ticket *Ticket) (bool, error) {
 	b.mu.Lock()
 	defer b.mu.Unlock()
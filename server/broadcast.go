@@ -381,6 +381,24 @@ func (sp *SessionPool) removeSession(session *BroadcastSession) {
 	delete(sp.sessMap, session.Transcoder())
 }
 
+// currentSessions returns the sessions that were last selected to transcode segments
+func (sp *SessionPool) currentSessions() []*BroadcastSession {
+	sp.lock.Lock()
+	defer sp.lock.Unlock()
+	return append([]*BroadcastSession{}, sp.lastSess...)
+}
+
+// clearCurrentSessions clears the sessions that were last selected so that they are not reused
+// and returns them
+func (sp *SessionPool) clearCurrentSessions() []*BroadcastSession {
+	sp.lock.Lock()
+	defer sp.lock.Unlock()
+
+	sessions := sp.lastSess
+	sp.lastSess = nil
+	return sessions
+}
+
 func (sp *SessionPool) cleanup() {
 	sp.lock.Lock()
 	defer sp.lock.Unlock()
@@ -505,6 +523,32 @@ func (bsm *BroadcastSessionsManager) removeSession(session *BroadcastSession) {
 	}
 }
 
+// currentOrchestrators returns the service URIs of the orchestrators that were last selected to transcode segments
+func (bsm *BroadcastSessionsManager) currentOrchestrators() []string {
+	return append(getOrchs(bsm.trustedPool.currentSessions()), getOrchs(bsm.untrustedPool.currentSessions())...)
+}
+
+// swapOrchestrators suspends and removes the sessions that are currently in use so that
+// the next segment is sent to a newly selected orchestrator
+// Returns the service URIs of the orchestrators that were removed
+func (bsm *BroadcastSessionsManager) swapOrchestrators(ctx context.Context) []string {
+	bsm.sessLock.Lock()
+	defer bsm.sessLock.Unlock()
+
+	orchs := []string{}
+	for _, sp := range []*SessionPool{bsm.trustedPool, bsm.untrustedPool} {
+		sessions := sp.clearCurrentSessions()
+		for _, sess := range sessions {
+			bsm.suspendAndRemoveOrch(sess)
+		}
+		if len(sessions) > 0 {
+			orchs = append(orchs, getOrchs(sessions)...)
+			go sp.refreshSessions(ctx)
+		}
+	}
+	return orchs
+}
+
 func (bs *BroadcastSession) pushSegInFlight(seg *stream.HLSSegment) {
 	bs.lock.Lock()
 	bs.SegsInFlight = append(bs.SegsInFlight,
@@ -842,6 +886,7 @@ func selectOrchestrator(ctx context.Context, n *core.LivepeerNode, params *core.
 			lock:              &sync.RWMutex{},
 			OrchestratorScore: oScore,
 			InitialPrice:      od.RemoteInfo.PriceInfo,
+			DiscoveryLatency:  od.Latency,
 		}
 
 		sessions = append(sessions, session)
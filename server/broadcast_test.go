@@ -477,6 +477,35 @@ func TestSelectSession_NoSegsInFlight(t *testing.T) {
 	assert.Equal(sess, s)
 }
 
+func TestSwapOrchestrators(t *testing.T) {
+	assert := assert.New(t)
+	ctx := context.Background()
+
+	sess1 := StubBroadcastSession("transcoder1")
+	sess2 := StubBroadcastSession("transcoder2")
+	bsm := bsmWithSessListExt([]*BroadcastSession{sess1, sess2}, nil, true)
+
+	// No sessions selected yet
+	assert.Empty(bsm.currentOrchestrators())
+	assert.Empty(bsm.swapOrchestrators(ctx))
+
+	sess := bsm.trustedPool.selectSessions(ctx, 1)[0]
+	assert.Equal([]string{sess.Transcoder()}, bsm.currentOrchestrators())
+
+	// Swapped out orchestrator is suspended and removed from the pool
+	assert.Equal([]string{sess.Transcoder()}, bsm.swapOrchestrators(ctx))
+	assert.Greater(bsm.trustedPool.sus.Suspended(sess.Transcoder()), 0)
+	_, ok := bsm.trustedPool.sessMap[sess.Transcoder()]
+	assert.False(ok)
+	assert.Empty(bsm.currentOrchestrators())
+
+	// Next selection uses a different orchestrator
+	sessions := bsm.trustedPool.selectSessions(ctx, 1)
+	assert.Len(sessions, 1)
+	assert.NotEqual(sess.Transcoder(), sessions[0].Transcoder())
+	assert.Equal([]string{sessions[0].Transcoder()}, bsm.currentOrchestrators())
+}
+
 // Note: Add processSegment tests, including:
 //     assert an error from transcoder removes sess from BroadcastSessionManager
 //     assert a success re-adds sess to BroadcastSessionManager
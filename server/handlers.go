@@ -1,6 +1,7 @@
 package server
 
 import (
+	"crypto/subtle"
 	"encoding/json"
 	"fmt"
 	"math/big"
@@ -16,6 +17,7 @@ import (
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 	"github.com/golang/glog"
+	"github.com/livepeer/go-livepeer/clog"
 	"github.com/livepeer/go-livepeer/common"
 	"github.com/livepeer/go-livepeer/core"
 	"github.com/livepeer/go-livepeer/eth"
@@ -48,12 +50,43 @@ func (s *LivepeerServer) manifestIdHandler() http.Handler {
 	})
 }
 
-func localStreamsHandler() http.Handler {
+func (s *LivepeerServer) localStreamsHandler() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		// XXX fetch local streams?
-		ret := make([]map[string]string, 0)
+		respondJson(w, s.GetActiveStreams())
+	})
+}
 
-		respondJson(w, ret)
+// Stream control
+func (s *LivepeerServer) stopStreamHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mid := core.ManifestID(r.FormValue("manifestID"))
+		ctx := clog.AddManifestID(r.Context(), string(mid))
+		if err := stopStream(ctx, s, mid); err != nil {
+			if err == errUnknownStream {
+				respondWithError(w, fmt.Sprintf("unknown stream manifestID=%s", mid), http.StatusNotFound)
+				return
+			}
+			respond500(w, err.Error())
+			return
+		}
+		respondOk(w, []byte(fmt.Sprintf("Stopped stream manifestID=%s\n", mid)))
+	})
+}
+
+func (s *LivepeerServer) swapOrchestratorHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mid := core.ManifestID(r.FormValue("manifestID"))
+		ctx := clog.AddManifestID(r.Context(), string(mid))
+		orchs, err := swapRTMPStreamOrchestrators(ctx, s, mid)
+		if err != nil {
+			if err == errUnknownStream {
+				respondWithError(w, fmt.Sprintf("unknown stream manifestID=%s", mid), http.StatusNotFound)
+				return
+			}
+			respond500(w, err.Error())
+			return
+		}
+		respondJson(w, orchs)
 	})
 }
 
@@ -1480,6 +1513,22 @@ func respondWithError(w http.ResponseWriter, errMsg string, code int) {
 	http.Error(w, errMsg, code)
 }
 
+// mustHaveStreamControlToken rejects requests without an "Authorization: Bearer <token>" header matching
+// the configured stream control token. Requests are not checked if no token is configured
+func (s *LivepeerServer) mustHaveStreamControlToken(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if s.StreamControlToken != "" {
+			auth := r.Header.Get("Authorization")
+			if !strings.HasPrefix(auth, "Bearer ") || subtle.ConstantTimeCompare([]byte(strings.TrimPrefix(auth, "Bearer ")), []byte(s.StreamControlToken)) != 1 {
+				respondWithError(w, "invalid stream control token", http.StatusUnauthorized)
+				return
+			}
+		}
+
+		h.ServeHTTP(w, r)
+	})
+}
+
 func mustHaveFormParams(h http.Handler, params ...string) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if err := r.ParseForm(); err != nil {
@@ -1465,6 +1465,37 @@ func TestTicketBrokerParamsHandler_Success(t *testing.T) {
 }
 
 // Helpers
+func TestMustHaveStreamControlToken(t *testing.T) {
+	assert := assert.New(t)
+	s := stubServer()
+
+	// No token configured
+	handler := s.mustHaveStreamControlToken(dummyHandler())
+	status, body := post(handler)
+	assert.Equal(http.StatusOK, status)
+	assert.Equal("success", body)
+
+	s.StreamControlToken = "foo"
+
+	// Missing token
+	status, body = post(handler)
+	assert.Equal(http.StatusUnauthorized, status)
+	assert.Equal("invalid stream control token", body)
+
+	// Wrong token
+	status, _ = postFormWithHeaders(handler, url.Values{}, map[string]string{"Authorization": "Bearer bar"})
+	assert.Equal(http.StatusUnauthorized, status)
+
+	// Token without bearer scheme
+	status, _ = postFormWithHeaders(handler, url.Values{}, map[string]string{"Authorization": "foo"})
+	assert.Equal(http.StatusUnauthorized, status)
+
+	// Correct token
+	status, body = postFormWithHeaders(handler, url.Values{}, map[string]string{"Authorization": "Bearer foo"})
+	assert.Equal(http.StatusOK, status)
+	assert.Equal("success", body)
+}
+
 func TestMustHaveFormParams_NoParamsRequired(t *testing.T) {
 	assert := assert.New(t)
 
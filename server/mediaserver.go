@@ -21,6 +21,7 @@ import (
 	"path"
 	"regexp"
 	"runtime"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -109,7 +110,9 @@ type LivepeerServer struct {
 	LivepeerNode            *core.LivepeerNode
 	HTTPMux                 *http.ServeMux
 	ExposeCurrentManifest   bool
+	StreamControlToken      string
 	recordingsAuthResponses *cache.Cache
+	stoppedStreams          *cache.Cache
 
 	// Thread sensitive fields. All accesses to the
 	// following fields should be protected by `connectionLock`
@@ -184,6 +187,7 @@ func NewLivepeerServer(rtmpAddr string, lpNode *core.LivepeerNode, httpIngest bo
 		rtmpConnections:         make(map[core.ManifestID]*rtmpConnection),
 		internalManifests:       make(map[core.ManifestID]core.ManifestID),
 		recordingsAuthResponses: cache.New(time.Hour, 2*time.Hour),
+		stoppedStreams:          cache.New(httpPushTimeout, 2*httpPushTimeout),
 	}
 	if lpNode.NodeType == core.BroadcasterNode && httpIngest {
 		opts.HttpMux.HandleFunc("/live/", ls.HandlePush)
@@ -589,6 +593,12 @@ func removeRTMPStream(ctx context.Context, s *LivepeerServer, extmid core.Manife
 	clog.Infof(ctx, "Ended stream with manifestID=%s external manifestID=%s", intmid, extmid)
 	delete(s.rtmpConnections, intmid)
 	delete(s.internalManifests, extmid)
+	// Other external manifestIDs can still map to the removed stream if it was ended by its internal manifestID
+	for mid, _intmid := range s.internalManifests {
+		if _intmid == intmid {
+			delete(s.internalManifests, mid)
+		}
+	}
 
 	if monitor.Enabled {
 		monitor.StreamEnded(ctx, cxn.nonce)
@@ -599,6 +609,51 @@ func removeRTMPStream(ctx context.Context, s *LivepeerServer, extmid core.Manife
 	return nil
 }
 
+// stopStream ends the stream with the provided internal or external manifestID. HTTP pushes to any of the
+// stream's manifestIDs are rejected until none have been received for httpPushTimeout so that segments
+// that are still being pushed do not recreate the stream
+func stopStream(ctx context.Context, s *LivepeerServer, mid core.ManifestID) error {
+	s.connectionLock.RLock()
+	intmid := mid
+	if _intmid, exists := s.internalManifests[mid]; exists {
+		intmid = _intmid
+	}
+	mids := []core.ManifestID{intmid}
+	for extmid, _intmid := range s.internalManifests {
+		if _intmid == intmid && extmid != intmid {
+			mids = append(mids, extmid)
+		}
+	}
+	s.connectionLock.RUnlock()
+
+	if err := removeRTMPStream(ctx, s, intmid); err != nil {
+		return err
+	}
+	for _, mid := range mids {
+		s.stoppedStreams.Set(string(mid), struct{}{}, httpPushTimeout)
+	}
+	return nil
+}
+
+// swapRTMPStreamOrchestrators forces the stream with the provided manifestID to switch away from the
+// orchestrators it is currently using. Returns the service URIs of the orchestrators that were swapped out
+func swapRTMPStreamOrchestrators(ctx context.Context, s *LivepeerServer, extmid core.ManifestID) ([]string, error) {
+	s.connectionLock.RLock()
+	intmid := extmid
+	if _intmid, exists := s.internalManifests[extmid]; exists {
+		intmid = _intmid
+	}
+	cxn, ok := s.getActiveRtmpConnectionUnsafe(intmid)
+	s.connectionLock.RUnlock()
+	if !ok || cxn.pl == nil {
+		clog.Warningf(ctx, "Attempted to swap orchestrators for unknown stream with manifestID=%s", extmid)
+		return nil, errUnknownStream
+	}
+	orchs := cxn.sessManager.swapOrchestrators(ctx)
+	clog.Infof(ctx, "Swapped orchestrators for stream with manifestID=%s external manifestID=%s orchs=%v", intmid, extmid, orchs)
+	return orchs, nil
+}
+
 //End RTMP Publish Handlers
 
 // HLS Play Handlers
@@ -828,6 +883,12 @@ func (s *LivepeerServer) HandlePush(w http.ResponseWriter, r *http.Request) {
 
 	// Check for presence and register if a fresh cxn
 	if !exists {
+		if _, stopped := s.stoppedStreams.Get(string(mid)); stopped {
+			// Keep rejecting the stream for as long as segments are pushed for it
+			s.stoppedStreams.Set(string(mid), struct{}{}, httpPushTimeout)
+			errorOut(http.StatusForbidden, "Stream was stopped url=%s", r.URL)
+			return
+		}
 		appData, err := (createRTMPStreamIDHandler(ctx, s, authHeaderConfig))(r.URL)
 		if err != nil {
 			if errors.Is(err, errForbidden) {
@@ -1623,6 +1684,40 @@ func (s *LivepeerServer) GetNodeStatus() *common.NodeStatus {
 	return res
 }
 
+// GetActiveStreams returns the streams that are currently being transcoded
+func (s *LivepeerServer) GetActiveStreams() []common.ActiveStream {
+	s.connectionLock.RLock()
+	defer s.connectionLock.RUnlock()
+
+	externalManifests := make(map[core.ManifestID]core.ManifestID)
+	for extmid, intmid := range s.internalManifests {
+		externalManifests[intmid] = extmid
+	}
+
+	streams := make([]common.ActiveStream, 0, len(s.rtmpConnections))
+	for mid := range s.rtmpConnections {
+		cxn, _ := s.getActiveRtmpConnectionUnsafe(mid)
+		if cxn.pl == nil {
+			continue
+		}
+		profiles := make([]string, len(cxn.params.Profiles))
+		for i, p := range cxn.params.Profiles {
+			profiles[i] = p.Name
+		}
+		streams = append(streams, common.ActiveStream{
+			ManifestID:         string(mid),
+			ExternalManifestID: string(externalManifests[mid]),
+			Profiles:           profiles,
+			Orchestrators:      cxn.sessManager.currentOrchestrators(),
+			SourceBytes:        atomic.LoadUint64(&cxn.sourceBytes),
+			TranscodedBytes:    atomic.LoadUint64(&cxn.transcodedBytes),
+			LastUsed:           cxn.lastUsed,
+		})
+	}
+	sort.Slice(streams, func(i, j int) bool { return streams[i].ManifestID < streams[j].ManifestID })
+	return streams
+}
+
 // Debug helpers
 func (s *LivepeerServer) LatestPlaylist() core.PlaylistManager {
 	s.connectionLock.RLock()
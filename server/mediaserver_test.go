@@ -955,6 +955,129 @@ func TestEndRTMPStreamHandler(t *testing.T) {
 	st.Close()
 }
 
+func TestStreamControlHandlers(t *testing.T) {
+	assert := assert.New(t)
+	s, cancel := setupServerWithCancel()
+	defer serverCleanup(s)
+	defer cancel()
+	s.RTMPSegmenter = &StubSegmenter{skip: true}
+	createSid := createRTMPStreamIDHandler(context.TODO(), s, nil)
+	handler := gotRTMPStreamHandler(s)
+	u := mustParseUrl(t, "rtmp://localhost")
+	sid, err := createSid(u)
+	require.NoError(t, err)
+	st := stream.NewBasicRTMPVideoStream(sid)
+	defer st.Close()
+	mid := string(sid.(*core.StreamParameters).ManifestID)
+
+	// No active streams
+	status, body := get(s.localStreamsHandler())
+	assert.Equal(http.StatusOK, status)
+	assert.Equal("[]", body)
+
+	// Unknown stream
+	status, _ = postForm(s.swapOrchestratorHandler(), url.Values{"manifestID": {mid}})
+	assert.Equal(http.StatusNotFound, status)
+	status, _ = postForm(s.stopStreamHandler(), url.Values{"manifestID": {mid}})
+	assert.Equal(http.StatusNotFound, status)
+
+	require.NoError(t, handler(u, st))
+
+	status, body = get(s.localStreamsHandler())
+	assert.Equal(http.StatusOK, status)
+	var streams []common.ActiveStream
+	require.NoError(t, json.Unmarshal([]byte(body), &streams))
+	require.Len(t, streams, 1)
+	assert.Equal(mid, streams[0].ManifestID)
+	assert.Len(streams[0].Profiles, len(BroadcastJobVideoProfiles))
+
+	// Use a session manager with a selected session
+	sess1 := StubBroadcastSession("transcoder1")
+	sess2 := StubBroadcastSession("transcoder2")
+	bsm := bsmWithSessListExt([]*BroadcastSession{sess1, sess2}, nil, true)
+	sess := bsm.trustedPool.selectSessions(context.TODO(), 1)[0]
+	s.connectionLock.Lock()
+	s.rtmpConnections[core.ManifestID(mid)].sessManager = bsm
+	s.connectionLock.Unlock()
+
+	streams = s.GetActiveStreams()
+	require.Len(t, streams, 1)
+	assert.Equal([]string{sess.Transcoder()}, streams[0].Orchestrators)
+
+	status, body = postForm(s.swapOrchestratorHandler(), url.Values{"manifestID": {mid}})
+	assert.Equal(http.StatusOK, status)
+	var orchs []string
+	require.NoError(t, json.Unmarshal([]byte(body), &orchs))
+	assert.Equal([]string{sess.Transcoder()}, orchs)
+
+	status, body = get(s.localStreamsHandler())
+	assert.Equal(http.StatusOK, status)
+	require.NoError(t, json.Unmarshal([]byte(body), &streams))
+	require.Len(t, streams, 1)
+	assert.Empty(streams[0].Orchestrators)
+
+	status, _ = postForm(s.stopStreamHandler(), url.Values{"manifestID": {mid}})
+	assert.Equal(http.StatusOK, status)
+	assert.Empty(s.GetActiveStreams())
+}
+
+func TestStopStream_ExternalManifestID(t *testing.T) {
+	assert := assert.New(t)
+	s, cancel := setupServerWithCancel()
+	defer serverCleanup(s)
+	defer cancel()
+	s.RTMPSegmenter = &StubSegmenter{skip: true}
+	createSid := createRTMPStreamIDHandler(context.TODO(), s, nil)
+	handler := gotRTMPStreamHandler(s)
+
+	startStream := func(extmid core.ManifestID) core.ManifestID {
+		u := mustParseUrl(t, "rtmp://localhost")
+		sid, err := createSid(u)
+		require.NoError(t, err)
+		st := stream.NewBasicRTMPVideoStream(sid)
+		t.Cleanup(func() { st.Close() })
+		require.NoError(t, handler(u, st))
+		intmid := sid.(*core.StreamParameters).ManifestID
+		s.connectionLock.Lock()
+		s.internalManifests[extmid] = intmid
+		s.connectionLock.Unlock()
+		return intmid
+	}
+
+	// Stop by external manifestID
+	intmid := startStream("extmid1")
+	status, _ := postForm(s.stopStreamHandler(), url.Values{"manifestID": {"extmid1"}})
+	assert.Equal(http.StatusOK, status)
+	s.connectionLock.RLock()
+	_, exists := s.rtmpConnections[intmid]
+	_, extExists := s.internalManifests["extmid1"]
+	s.connectionLock.RUnlock()
+	assert.False(exists)
+	assert.False(extExists)
+	_, stopped := s.stoppedStreams.Get("extmid1")
+	assert.True(stopped)
+	_, stopped = s.stoppedStreams.Get(string(intmid))
+	assert.True(stopped)
+
+	// Stop by internal manifestID removes the external manifestID mapping
+	intmid = startStream("extmid2")
+	status, _ = postForm(s.stopStreamHandler(), url.Values{"manifestID": {string(intmid)}})
+	assert.Equal(http.StatusOK, status)
+	s.connectionLock.RLock()
+	_, exists = s.rtmpConnections[intmid]
+	_, extExists = s.internalManifests["extmid2"]
+	s.connectionLock.RUnlock()
+	assert.False(exists)
+	assert.False(extExists)
+	_, stopped = s.stoppedStreams.Get("extmid2")
+	assert.True(stopped)
+
+	// Stopped stream is unknown
+	status, _ = postForm(s.stopStreamHandler(), url.Values{"manifestID": {"extmid2"}})
+	assert.Equal(http.StatusNotFound, status)
+	assert.Empty(s.GetActiveStreams())
+}
+
 // Should publish RTMP stream, turn the RTMP stream into HLS, and broadcast the HLS stream.
 func TestGotRTMPStreamHandler(t *testing.T) {
 	s, cancel := setupServerWithCancel()
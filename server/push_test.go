@@ -774,6 +774,71 @@ func TestPush_ShouldRemoveSessionAfterTimeoutIfInternalMIDIsUsed(t *testing.T) {
 	assert.False(extEx)
 }
 
+func TestPush_StoppedStreamIsNotRecreated(t *testing.T) {
+	defer goleak.VerifyNone(t, common.IgnoreRoutines()...)
+
+	oldRI := httpPushTimeout
+	httpPushTimeout = 100 * time.Millisecond
+	defer func() { httpPushTimeout = oldRI }()
+	assert := assert.New(t)
+
+	// wait for any earlier tests to complete
+	assert.True(wgWait(&pushResetWg), "timed out waiting for earlier tests")
+
+	s, cancel := setupServerWithCancel()
+	defer cancel()
+
+	hookCalled := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth := authWebhookResponse{ManifestID: "intmid"}
+		val, err := json.Marshal(auth)
+		assert.Nil(err, "invalid auth webhook response")
+		w.Write(val)
+		hookCalled++
+	}))
+	defer ts.Close()
+	oldURL := AuthWebhookURL
+	defer func() { AuthWebhookURL = oldURL }()
+	AuthWebhookURL = mustParseUrl(t, ts.URL)
+
+	push := func() int {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("POST", "/live/extmid1/1.ts", nil)
+		s.HandlePush(w, req)
+		resp := w.Result()
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	push()
+	assert.Equal(1, hookCalled)
+
+	// Stop the stream with the internal manifestID that is listed by /localStreams
+	assert.Nil(stopStream(context.TODO(), s, "intmid"))
+
+	// Segments pushed after the stream was stopped are rejected
+	assert.Equal(http.StatusForbidden, push())
+	assert.Equal(1, hookCalled)
+	s.connectionLock.Lock()
+	_, exists := s.rtmpConnections["intmid"]
+	_, extEx := s.internalManifests["extmid1"]
+	s.connectionLock.Unlock()
+	assert.False(exists)
+	assert.False(extEx)
+
+	// The stream can be pushed again once pushes stopped arriving for httpPushTimeout
+	time.Sleep(150 * time.Millisecond)
+	push()
+	assert.Equal(2, hookCalled)
+	s.connectionLock.Lock()
+	_, exists = s.rtmpConnections["intmid"]
+	s.connectionLock.Unlock()
+	assert.True(exists)
+
+	// Wait for the watchdog to remove the stream
+	time.Sleep(250 * time.Millisecond)
+}
+
 func TestPush_ShouldRemoveSessionAfterTimeout(t *testing.T) {
 	defer goleak.VerifyNone(t, common.IgnoreRoutines()...)
 
@@ -110,6 +110,7 @@ type BroadcastSession struct {
 	Balances                 *core.AddressBalances
 	OrchestratorScore        float32
 	VerifiedByPerceptualHash bool
+	DiscoveryLatency         time.Duration
 	lock                     *sync.RWMutex
 	// access these fields under the lock
 	SegsInFlight     []SegFlightMetadata
@@ -4,6 +4,7 @@ import (
 	"container/heap"
 	"context"
 	"math/big"
+	"time"
 
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/livepeer/go-livepeer/clog"
@@ -170,6 +171,7 @@ func (s *MinLSSelector) selectUnknownSession(ctx context.Context) *BroadcastSess
 
 	var addrs []ethcommon.Address
 	prices := map[ethcommon.Address]*big.Rat{}
+	latencies := map[ethcommon.Address]time.Duration{}
 	addrCount := make(map[ethcommon.Address]int)
 	for _, sess := range s.unknownSessions {
 		if sess.OrchestratorInfo.GetTicketParams() == nil {
@@ -184,6 +186,10 @@ func (s *MinLSSelector) selectUnknownSession(ctx context.Context) *BroadcastSess
 		if pi != nil && pi.PixelsPerUnit != 0 {
 			prices[addr] = big.NewRat(pi.PricePerUnit, pi.PixelsPerUnit)
 		}
+		// Keep the lowest latency if multiple sessions share the same address
+		if l := sess.DiscoveryLatency; l > 0 && (latencies[addr] == 0 || l < latencies[addr]) {
+			latencies[addr] = l
+		}
 	}
 	maxPrice := BroadcastCfg.MaxPrice()
 
@@ -202,7 +208,7 @@ func (s *MinLSSelector) selectUnknownSession(ctx context.Context) *BroadcastSess
 		s.perfScore.Mu.Unlock()
 	}
 
-	selected := s.selectionAlgorithm.Select(ctx, addrs, stakes, maxPrice, prices, perfScores)
+	selected := s.selectionAlgorithm.Select(ctx, addrs, stakes, maxPrice, prices, perfScores, latencies)
 
 	for i, sess := range s.unknownSessions {
 		if sess.OrchestratorInfo.GetTicketParams() == nil {
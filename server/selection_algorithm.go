@@ -20,12 +20,15 @@ type ProbabilitySelectionAlgorithm struct {
 	PriceWeight float64
 	RandWeight  float64
 
+	// weight of the round trip time that is measured once when an orchestrator is discovered
+	DiscoveryLatencyWeight float64
+
 	PriceExpFactor float64
 }
 
-func (sa ProbabilitySelectionAlgorithm) Select(ctx context.Context, addrs []ethcommon.Address, stakes map[ethcommon.Address]int64, maxPrice *big.Rat, prices map[ethcommon.Address]*big.Rat, perfScores map[ethcommon.Address]float64) ethcommon.Address {
+func (sa ProbabilitySelectionAlgorithm) Select(ctx context.Context, addrs []ethcommon.Address, stakes map[ethcommon.Address]int64, maxPrice *big.Rat, prices map[ethcommon.Address]*big.Rat, perfScores map[ethcommon.Address]float64, latencies map[ethcommon.Address]time.Duration) ethcommon.Address {
 	filtered := sa.filter(ctx, addrs, maxPrice, prices, perfScores)
-	probabilities := sa.calculateProbabilities(filtered, stakes, prices)
+	probabilities := sa.calculateProbabilities(filtered, stakes, prices, latencies)
 	return selectBy(probabilities)
 }
 
@@ -79,17 +82,27 @@ func (sa ProbabilitySelectionAlgorithm) filterByMaxPrice(ctx context.Context, ad
 	return res
 }
 
-func (sa ProbabilitySelectionAlgorithm) calculateProbabilities(addrs []ethcommon.Address, stakes map[ethcommon.Address]int64, prices map[ethcommon.Address]*big.Rat) map[ethcommon.Address]float64 {
+func (sa ProbabilitySelectionAlgorithm) calculateProbabilities(addrs []ethcommon.Address, stakes map[ethcommon.Address]int64, prices map[ethcommon.Address]*big.Rat, latencies map[ethcommon.Address]time.Duration) map[ethcommon.Address]float64 {
 	pricesNorm := map[ethcommon.Address]float64{}
 	for _, addr := range addrs {
 		p, _ := prices[addr].Float64()
 		pricesNorm[addr] = math.Exp(-1 * p / sa.PriceExpFactor)
 	}
 
-	var priceSum, stakeSum float64
+	// Orchestrators with a lower latency are more likely to be selected, the ones
+	// with an unknown latency are never selected by the latency factor
+	latenciesNorm := map[ethcommon.Address]float64{}
+	for _, addr := range addrs {
+		if l := latencies[addr]; l > 0 {
+			latenciesNorm[addr] = 1 / l.Seconds()
+		}
+	}
+
+	var priceSum, stakeSum, latencySum float64
 	for _, addr := range addrs {
 		priceSum += pricesNorm[addr]
 		stakeSum += float64(stakes[addr])
+		latencySum += latenciesNorm[addr]
 	}
 
 	probs := map[ethcommon.Address]float64{}
@@ -102,9 +115,13 @@ func (sa ProbabilitySelectionAlgorithm) calculateProbabilities(addrs []ethcommon
 		if stakeSum != 0 {
 			stakeProb = float64(stakes[addr]) / stakeSum
 		}
+		latencyProb := 1.0
+		if latencySum != 0 {
+			latencyProb = latenciesNorm[addr] / latencySum
+		}
 		randProb := 1.0 / float64(len(addrs))
 
-		probs[addr] = sa.PriceWeight*priceProb + sa.StakeWeight*stakeProb + sa.RandWeight*randProb
+		probs[addr] = sa.PriceWeight*priceProb + sa.StakeWeight*stakeProb + sa.RandWeight*randProb + sa.DiscoveryLatencyWeight*latencyProb
 	}
 
 	return probs
@@ -4,6 +4,7 @@ import (
 	"context"
 	"math/big"
 	"testing"
+	"time"
 
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
@@ -222,14 +223,16 @@ func TestFilter(t *testing.T) {
 
 func TestCalculateProbabilities(t *testing.T) {
 	tests := []struct {
-		name        string
-		addrs       []string
-		stakes      []int64
-		prices      []float64
-		stakeWeight float64
-		priceWeight float64
-		randWeight  float64
-		want        []float64
+		name          string
+		addrs         []string
+		stakes        []int64
+		prices        []float64
+		latencies     []time.Duration
+		stakeWeight   float64
+		priceWeight   float64
+		randWeight    float64
+		latencyWeight float64
+		want          []float64
 	}{
 		{
 			name:        "Stake and Price weights",
@@ -264,6 +267,25 @@ func TestCalculateProbabilities(t *testing.T) {
 			stakeWeight: 1.0,
 			want:        []float64{0.1, 0.1, 0.8},
 		},
+		{
+			name:          "Latency selection",
+			addrs:         []string{"0x0000000000000000000000000000000000000001", "0x0000000000000000000000000000000000000002", "0x0000000000000000000000000000000000000003"},
+			stakes:        []int64{100, 100, 800},
+			prices:        []float64{400, 700, 1000},
+			latencies:     []time.Duration{100 * time.Millisecond, 100 * time.Millisecond, 200 * time.Millisecond},
+			latencyWeight: 1.0,
+			want:          []float64{0.4, 0.4, 0.2},
+		},
+		{
+			name:          "Stake and Latency weights with unknown latency",
+			addrs:         []string{"0x0000000000000000000000000000000000000001", "0x0000000000000000000000000000000000000002", "0x0000000000000000000000000000000000000003"},
+			stakes:        []int64{100, 100, 800},
+			prices:        []float64{400, 700, 1000},
+			latencies:     []time.Duration{100 * time.Millisecond, 300 * time.Millisecond, 0},
+			stakeWeight:   0.5,
+			latencyWeight: 0.5,
+			want:          []float64{0.425, 0.175, 0.4},
+		},
 	}
 
 	for _, tt := range tests {
@@ -271,12 +293,16 @@ func TestCalculateProbabilities(t *testing.T) {
 			var orchs []ethcommon.Address
 			stakes := map[ethcommon.Address]int64{}
 			prices := map[ethcommon.Address]*big.Rat{}
+			latencies := map[ethcommon.Address]time.Duration{}
 			expProbs := map[ethcommon.Address]float64{}
 			for i, addrStr := range tt.addrs {
 				addr := ethcommon.HexToAddress(addrStr)
 				orchs = append(orchs, addr)
 				stakes[addr] = tt.stakes[i]
 				prices[addr] = new(big.Rat).SetFloat64(tt.prices[i])
+				if tt.latencies != nil {
+					latencies[addr] = tt.latencies[i]
+				}
 				expProbs[addr] = tt.want[i]
 			}
 
@@ -285,9 +311,11 @@ func TestCalculateProbabilities(t *testing.T) {
 				PriceWeight:    tt.priceWeight,
 				RandWeight:     tt.randWeight,
 				PriceExpFactor: testPriceExpFactor,
+
+				DiscoveryLatencyWeight: tt.latencyWeight,
 			}
 
-			probs := sa.calculateProbabilities(orchs, stakes, prices)
+			probs := sa.calculateProbabilities(orchs, stakes, prices, latencies)
 
 			require.Len(t, probs, len(expProbs))
 			for addr, expProb := range expProbs {
@@ -38,7 +38,6 @@ func (s *LivepeerServer) cliWebServerHandlers(bindAddr string) *http.ServeMux {
 	mux.Handle("/status", s.statusHandler())
 	mux.Handle("/streamID", s.streamIdHandler())
 	mux.Handle("/manifestID", s.manifestIdHandler())
-	mux.Handle("/localStreams", localStreamsHandler())
 	mux.Handle("/EthChainID", ethChainIdHandler(db))
 	mux.Handle("/currentBlock", currentBlockHandler(db))
 	mux.Handle("/orchestratorInfo", s.orchestratorInfoHandler(client))
@@ -50,6 +49,11 @@ func (s *LivepeerServer) cliWebServerHandlers(bindAddr string) *http.ServeMux {
 	mux.Handle("/getBroadcastConfig", getBroadcastConfigHandler())
 	mux.Handle("/getAvailableTranscodingOptions", getAvailableTranscodingOptionsHandler())
 
+	// Stream control
+	mux.Handle("/localStreams", s.mustHaveStreamControlToken(s.localStreamsHandler()))
+	mux.Handle("/stopStream", s.mustHaveStreamControlToken(mustHaveFormParams(s.stopStreamHandler(), "manifestID")))
+	mux.Handle("/swapOrchestrator", s.mustHaveStreamControlToken(mustHaveFormParams(s.swapOrchestratorHandler(), "manifestID")))
+
 	// Rounds
 	mux.Handle("/currentRound", currentRoundHandler(client))
 	mux.Handle("/initializeRound", initializeRoundHandler(client))